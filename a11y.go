@@ -0,0 +1,18 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// LabelFor associates label with input for assistive technology: the input, or any other component like a panel, gets
+// an aria-labelledby attribute holding the id of the label, and the text of the label as aria-label for the
+// technologies that don't follow references. Forms and the other labeled helpers do this automatically.
+func (g *GuiBuilder) LabelFor(label gwu.Label, input gwu.Comp) {
+	input.SetAttr("aria-labelledby", label.ID().String())
+	setAriaLabel(input, label.Text())
+}
+
+// setAriaLabel sets the accessible name of a component that has no visible label.
+func setAriaLabel(comp gwu.Comp, text string) {
+	comp.SetAttr("aria-label", text)
+}
@@ -0,0 +1,72 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_LabelFor(t *testing.T) {
+	g := &GuiBuilder{}
+	label := g.MakeLabel("Name", Options{})
+	tb := g.MakeTextBox("", Options{})
+
+	g.LabelFor(label, tb)
+	assert.Equal(t, label.ID().String(), tb.Attr("aria-labelledby"))
+	assert.Equal(t, "Name", tb.Attr("aria-label"))
+	assert.Contains(t, renderHTML(tb), `aria-labelledby="`+label.ID().String()+`"`)
+}
+
+func TestForm_accessibility(t *testing.T) {
+	g := &GuiBuilder{}
+	form := g.MakeForm(Options{},
+		Field{Name: "name", Label: "Name", Required: true},
+		Field{Name: "age", Validators: []Validator{Numeric()}},
+		Field{Name: "admin", Label: "Admin", Kind: InputCheckBox},
+	)
+
+	name := form.Input("name")
+	assert.Equal(t, form.Label("name").ID().String(), name.Attr("aria-labelledby"))
+	assert.Equal(t, "Name *", name.Attr("aria-label"))
+	assert.Equal(t, "true", name.Attr("aria-required"))
+	assert.Equal(t, form.errLabels["name"].ID().String(), name.Attr("aria-describedby"))
+	assert.Equal(t, "polite", form.errLabels["name"].Attr("aria-live"))
+
+	age := form.Input("age")
+	assert.Equal(t, "age", age.Attr("aria-label"))
+	assert.Equal(t, "", age.Attr("aria-labelledby"))
+	assert.Equal(t, "", age.Attr("aria-required"))
+
+	admin := form.Input("admin")
+	assert.Equal(t, form.Label("admin").ID().String(), admin.Attr("aria-labelledby"))
+	assert.Equal(t, "", admin.Attr("aria-describedby"))
+
+	form.TextBox("age").SetText("x")
+	assert.Error(t, form.ValidateField("age"))
+	assert.Equal(t, "true", age.Attr("aria-invalid"))
+	form.TextBox("age").SetText("1")
+	assert.NoError(t, form.ValidateField("age"))
+	assert.Equal(t, "", age.Attr("aria-invalid"))
+}
+
+func TestLabeledComposites_accessibility(t *testing.T) {
+	g := &GuiBuilder{}
+
+	panes := g.MakeComparePanes("Old", "New", gwu.NewLabel("a"), gwu.NewLabel("b"), Options{})
+	for col, title := range []string{"Old", "New"} {
+		column := panes.CompAt(0, col).(gwu.Panel)
+		titleLabel, pane := column.CompAt(0).(gwu.Label), column.CompAt(1)
+		assert.Equal(t, title, titleLabel.Text())
+		assert.Equal(t, "region", pane.Attr("role"))
+		assert.Equal(t, titleLabel.ID().String(), pane.Attr("aria-labelledby"))
+	}
+
+	ft, err := g.MakeFilterableDataTable([]testServer{}, Options{ColumnFilters: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "Search", ft.FilterBox().Attr("aria-label"))
+	assert.Equal(t, "Filter Server name", ft.ColumnFilterBox(0).Attr("aria-label"))
+
+	sb := g.MakeSearchBar(nil, nil, Options{})
+	assert.Equal(t, "Search", sb.TextBox().Attr("aria-label"))
+}
@@ -0,0 +1,166 @@
+package wgowut
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// AccessibilityOptions configure the accessibility mode of a GuiBuilder, see GuiBuilder.SetAccessibilityMode. Zero
+// values leave the corresponding feature off.
+type AccessibilityOptions struct {
+	// FontScale multiplies the px and rem font sizes given in Options, components without a font size are set to
+	// FontScale rem. For example 1.25 makes text 25% larger.
+	FontScale float64
+
+	// MinContrast is the minimum contrast ratio between the text and background colors, for example 4.5 for WCAG AA.
+	// If the Color and Background options don't reach it, the text color is replaced with black or white. A missing
+	// Color is taken as black and a missing Background as white. Only named gwu colors and hex colors are checked.
+	MinContrast float64
+
+	// MinTargetSize is the minimum width and height of clickable components (buttons, list boxes, text boxes, check
+	// boxes), for example "44px".
+	MinTargetSize string
+}
+
+// SetAccessibilityMode applies the accessibility options to all components made by g from now on. Use a session
+// builder (see NewSessionBuilder) to toggle it per session, and the zero AccessibilityOptions to turn it off.
+func (g *GuiBuilder) SetAccessibilityMode(a11y AccessibilityOptions) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.a11y = a11y
+}
+
+// AccessibilityMode returns the accessibility options set with SetAccessibilityMode.
+func (g *GuiBuilder) AccessibilityMode() AccessibilityOptions {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.a11y
+}
+
+// styleOptions returns the options adjusted for the accessibility mode.
+func (g *GuiBuilder) styleOptions(options Options) Options {
+	a11y := g.AccessibilityMode()
+
+	if a11y.FontScale > 0 {
+		options.FontSize = scaleFontSize(options.FontSize, a11y.FontScale)
+	}
+
+	if a11y.MinContrast > 0 {
+		fg, bg := options.Color, options.Background
+		if fg == "" {
+			fg = gwu.ClrBlack
+		}
+		if bg == "" {
+			bg = gwu.ClrWhite
+		}
+		if ratio, ok := contrastRatio(fg, bg); ok && ratio < a11y.MinContrast {
+			black, _ := contrastRatio(gwu.ClrBlack, bg)
+			white, _ := contrastRatio(gwu.ClrWhite, bg)
+			if black >= white {
+				options.Color = gwu.ClrBlack
+			} else {
+				options.Color = gwu.ClrWhite
+			}
+		}
+	}
+
+	return options
+}
+
+// enlargeTarget applies the minimum target size of the accessibility mode and of the mobile layout to a clickable
+// component.
+func (g *GuiBuilder) enlargeTarget(comp gwu.Comp) {
+	if size := g.AccessibilityMode().MinTargetSize; size != "" {
+		comp.Style().Set("min-width", size).Set("min-height", size)
+	}
+	if g.MobileLayout().MinTargetSize != "" {
+		comp.Style().AddClass(touchTargetClass)
+	}
+}
+
+// scaleFontSize multiplies a px or rem font size by scale, a blank size is taken as 1rem. Other sizes are returned
+// unchanged.
+func scaleFontSize(size string, scale float64) string {
+	if size == "" {
+		size = "1rem"
+	}
+	for _, unit := range []string{"rem", "px"} {
+		if !strings.HasSuffix(size, unit) {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSuffix(size, unit), 64)
+		if err != nil {
+			return size
+		}
+		return strconv.FormatFloat(value*scale, 'f', -1, 64) + unit
+	}
+	return size
+}
+
+// namedColors holds the RGB values of the named gwu colors by lower case name.
+var namedColors = map[string][3]uint8{
+	"aqua": {0x00, 0xff, 0xff}, "black": {0x00, 0x00, 0x00}, "blue": {0x00, 0x00, 0xff},
+	"fuchsia": {0xff, 0x00, 0xff}, "gray": {0x80, 0x80, 0x80}, "grey": {0x80, 0x80, 0x80},
+	"green": {0x00, 0x80, 0x00}, "lime": {0x00, 0xff, 0x00}, "maroon": {0x80, 0x00, 0x00},
+	"navy": {0x00, 0x00, 0x80}, "olive": {0x80, 0x80, 0x00}, "purple": {0x80, 0x00, 0x80},
+	"red": {0xff, 0x00, 0x00}, "silver": {0xc0, 0xc0, 0xc0}, "teal": {0x00, 0x80, 0x80},
+	"white": {0xff, 0xff, 0xff}, "yellow": {0xff, 0xff, 0x00},
+}
+
+// parseColor returns the RGB values of a named gwu color or a #rgb or #rrggbb hex color.
+func parseColor(color string) (rgb [3]uint8, ok bool) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if rgb, ok := namedColors[color]; ok {
+		return rgb, true
+	}
+	if !strings.HasPrefix(color, "#") {
+		return rgb, false
+	}
+
+	hex := color[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return rgb, false
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return rgb, false
+	}
+	return [3]uint8{uint8(value >> 16), uint8(value >> 8), uint8(value)}, true
+}
+
+// relativeLuminance returns the WCAG relative luminance of the color.
+func relativeLuminance(rgb [3]uint8) float64 {
+	var channels [3]float64
+	for i, c := range rgb {
+		v := float64(c) / 255
+		if v <= 0.03928 {
+			channels[i] = v / 12.92
+		} else {
+			channels[i] = math.Pow((v+0.055)/1.055, 2.4)
+		}
+	}
+	return 0.2126*channels[0] + 0.7152*channels[1] + 0.0722*channels[2]
+}
+
+// contrastRatio returns the WCAG contrast ratio of two colors, from 1 to 21, and false if a color can't be parsed.
+func contrastRatio(color1, color2 string) (float64, bool) {
+	rgb1, ok1 := parseColor(color1)
+	rgb2, ok2 := parseColor(color2)
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+
+	l1, l2 := relativeLuminance(rgb1), relativeLuminance(rgb2)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05), true
+}
@@ -0,0 +1,94 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_scaleFontSize(t *testing.T) {
+	tests := []struct {
+		size  string
+		scale float64
+		want  string
+	}{
+		{"", 1.5, "1.5rem"},
+		{"12px", 1.5, "18px"},
+		{"2rem", 1.25, "2.5rem"},
+		{"small", 2, "small"},
+		{"120%", 2, "120%"},
+		{"abcpx", 2, "abcpx"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.size, func(t *testing.T) {
+			assert.Equal(t, tt.want, scaleFontSize(tt.size, tt.scale))
+		})
+	}
+}
+
+func Test_contrastRatio(t *testing.T) {
+	tests := []struct {
+		name           string
+		color1, color2 string
+		want           float64
+		wantOk         bool
+	}{
+		{"black on white", gwu.ClrBlack, gwu.ClrWhite, 21, true},
+		{"same color", "#abc", "#aabbcc", 1, true},
+		{"order doesn't matter", "white", "#000000", 21, true},
+		{"gray on white", gwu.ClrGray, gwu.ClrWhite, 3.95, true},
+		{"unknown name", "papayawhip", gwu.ClrWhite, 0, false},
+		{"bad hex", "#12345", gwu.ClrWhite, 0, false},
+		{"not hex", "#gggggg", gwu.ClrWhite, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := contrastRatio(tt.color1, tt.color2)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.InDelta(t, tt.want, got, 0.01)
+		})
+	}
+}
+
+func TestGuiBuilder_SetAccessibilityMode(t *testing.T) {
+	tests := []struct {
+		name        string
+		a11y        AccessibilityOptions
+		options     Options
+		wantColor   string
+		wantFont    string
+		wantMinSize string
+	}{
+		{"off", AccessibilityOptions{}, Options{Color: gwu.ClrSilver, FontSize: "10px"}, gwu.ClrSilver, "10px", ""},
+		{"font scale", AccessibilityOptions{FontScale: 2}, Options{FontSize: "10px"}, "", "20px", ""},
+		{"font scale without size", AccessibilityOptions{FontScale: 1.5}, Options{}, "", "1.5rem", ""},
+		{"low contrast on white", AccessibilityOptions{MinContrast: 4.5}, Options{Color: gwu.ClrSilver}, gwu.ClrBlack, "", ""},
+		{"low contrast on dark", AccessibilityOptions{MinContrast: 4.5}, Options{Color: gwu.ClrGray, Background: gwu.ClrNavy}, gwu.ClrWhite, "", ""},
+		{"default color on dark", AccessibilityOptions{MinContrast: 4.5}, Options{Background: gwu.ClrBlack}, gwu.ClrWhite, "", ""},
+		{"enough contrast", AccessibilityOptions{MinContrast: 4.5}, Options{Color: gwu.ClrNavy}, gwu.ClrNavy, "", ""},
+		{"unknown colors", AccessibilityOptions{MinContrast: 4.5}, Options{Color: "rgb(1,2,3)"}, "rgb(1,2,3)", "", ""},
+		{"target size", AccessibilityOptions{MinTargetSize: "44px"}, Options{}, "", "", "44px"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			g.SetAccessibilityMode(tt.a11y)
+			assert.Equal(t, tt.a11y, g.AccessibilityMode())
+
+			btn := g.MakeButton("OK", tt.options)
+			assert.Equal(t, tt.wantColor, btn.Style().Color())
+			assert.Equal(t, tt.wantFont, btn.Style().FontSize())
+			assert.Equal(t, tt.wantMinSize, btn.Style().Get("min-width"))
+			assert.Equal(t, tt.wantMinSize, btn.Style().Get("min-height"))
+
+			label := g.MakeLabel("text", tt.options)
+			assert.Equal(t, tt.wantColor, label.Style().Color())
+			assert.Equal(t, tt.wantFont, label.Style().FontSize())
+			assert.Equal(t, "", label.Style().Get("min-width"))
+
+			// builders are independent, so the mode can be toggled per session
+			assert.Equal(t, tt.options.FontSize, (&GuiBuilder{}).MakeLabel("text", tt.options).Style().FontSize())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// Section is a section of an accordion made by MakeAccordion, displaying Content under Title when expanded.
+type Section struct {
+	Title   string
+	Content gwu.Comp
+}
+
+// MakeAccordion creates a vertical panel of collapsed expanders, one for each section, with the titles as bold
+// headers; clicking a header expands or collapses its section. With the Exclusive option, expanding a section
+// collapses the others. The panel is made with MakePanel using options with LayoutVertical; the expanders are the
+// components of the panel, in the order of sections.
+func (g *GuiBuilder) MakeAccordion(sections []Section, options Options) gwu.Panel {
+	options.Layout = LayoutVertical
+	accordion := g.MakePanel(options)
+
+	expanders := make([]gwu.Expander, len(sections))
+	for i, section := range sections {
+		exp := gwu.NewExpander()
+		exp.SetHeader(g.MakeLabel(section.Title, Options{Bold: true}))
+		if section.Content != nil {
+			exp.SetContent(section.Content)
+		}
+		exp.Style().SetFullWidth()
+		if options.Exclusive {
+			exp.AddEHandlerFunc(exclusiveHandler(expanders, i), gwu.ETypeStateChange)
+		}
+		accordion.Add(exp)
+		expanders[i] = exp
+	}
+
+	return accordion
+}
+
+// exclusiveHandler returns the state change handler of the expander of index i collapsing the other expanders when
+// it's expanded.
+func exclusiveHandler(expanders []gwu.Expander, i int) func(gwu.Event) {
+	return func(e gwu.Event) {
+		if !expanders[i].Expanded() {
+			return
+		}
+		for j, exp := range expanders {
+			if j != i && exp.Expanded() {
+				exp.SetExpanded(false)
+				e.MarkDirty(exp)
+			}
+		}
+	}
+}
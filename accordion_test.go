@@ -0,0 +1,50 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeAccordion(t *testing.T) {
+	g := &GuiBuilder{}
+	sections := []Section{
+		{"General", g.MakeLabel("general settings", Options{})},
+		{"Network", g.MakeLabel("network settings", Options{})},
+		{"Advanced", nil},
+	}
+
+	for _, exclusive := range []bool{false, true} {
+		accordion := g.MakeAccordion(sections, Options{CellPadding: 2, Exclusive: exclusive})
+		assert.Equal(t, gwu.LayoutVertical, accordion.Layout())
+		assert.Equal(t, 2, accordion.CellPadding())
+		assert.Equal(t, 3, accordion.CompsCount())
+
+		expanders := make([]gwu.Expander, accordion.CompsCount())
+		for i := range expanders {
+			expanders[i] = accordion.CompAt(i).(gwu.Expander)
+			assert.False(t, expanders[i].Expanded())
+			assert.Equal(t, sections[i].Title, expanders[i].Header().(gwu.Label).Text())
+			assert.Equal(t, exclusive, expanders[i].HandlersCount(gwu.ETypeStateChange) == 1)
+		}
+		assert.Equal(t, sections[1].Content, expanders[1].Content())
+		assert.Nil(t, expanders[2].Content())
+	}
+
+	// expanding a section collapses the others
+	expanders := []gwu.Expander{gwu.NewExpander(), gwu.NewExpander(), gwu.NewExpander()}
+	expanders[0].SetExpanded(true)
+	expanders[1].SetExpanded(true)
+	e := &testEvent{etype: gwu.ETypeStateChange}
+	exclusiveHandler(expanders, 1)(e)
+	assert.False(t, expanders[0].Expanded())
+	assert.True(t, expanders[1].Expanded())
+	assert.Equal(t, []gwu.Comp{expanders[0]}, e.dirty)
+
+	// collapsing one leaves the others alone
+	expanders[2].SetExpanded(true)
+	exclusiveHandler(expanders, 0)(e)
+	assert.True(t, expanders[1].Expanded())
+	assert.True(t, expanders[2].Expanded())
+}
@@ -0,0 +1,125 @@
+package wgowut
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// AdminTimeFormat is the layout of the times displayed by the admin window, see MakeAdminWindow.
+const AdminTimeFormat = "2006-01-02 15:04:05"
+
+// sessionTracker is a session handler keeping track of the private sessions of a server, which gwu doesn't list.
+type sessionTracker struct {
+	mu       sync.Mutex
+	sessions map[string]gwu.Session
+}
+
+func (st *sessionTracker) Created(sess gwu.Session) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.sessions[sess.ID()] = sess
+}
+
+func (st *sessionTracker) Removed(sess gwu.Session) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	delete(st.sessions, sess.ID())
+}
+
+// list returns the tracked sessions ordered by creation time.
+func (st *sessionTracker) list() []gwu.Session {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	sessions := make([]gwu.Session, 0, len(st.sessions))
+	for _, sess := range st.sessions {
+		sessions = append(sessions, sess)
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].Created().Before(sessions[j].Created()) })
+	return sessions
+}
+
+// adminView holds the components of an admin window.
+type adminView struct {
+	g       *GuiBuilder
+	tracker *sessionTracker
+	win     gwu.Window
+	count   gwu.Label
+	table   gwu.Table
+}
+
+// MakeAdminWindow creates a window named "admin" listing the active private sessions of server with their creation
+// and last access times, with a button to invalidate each, as an operations page for any deployment. Add it to the
+// server (or to the session of an administrator, as anyone opening it can invalidate sessions) with AddWin.
+//
+// gwu doesn't list sessions, so the window registers a session handler with server and lists the sessions created
+// after this call: call it before starting the server. Invalidated sessions time out immediately and are removed by
+// the session cleanup of the server, which runs every 10 seconds. The list is updated by the Refresh button.
+func (g *GuiBuilder) MakeAdminWindow(server gwu.Server) gwu.Window {
+	av := g.newAdminView()
+	server.AddSHandler(av.tracker)
+	return av.win
+}
+
+// newAdminView creates the admin window with an empty session tracker.
+func (g *GuiBuilder) newAdminView() *adminView {
+	av := &adminView{g: g, tracker: &sessionTracker{sessions: make(map[string]gwu.Session)}}
+
+	av.win = g.MakeWindow("admin", "Sessions", Options{CellPadding: 4})
+	refresh := g.MakeButton("Refresh", Options{})
+	refresh.AddEHandlerFunc(av.refreshHandler, gwu.ETypeClick)
+	av.count = g.MakeLabel("", Options{})
+	av.win.Add(g.MakeToolbar(Options{}, g.MakeLabel("Active sessions", Options{Bold: true}), av.count, nil, refresh))
+
+	av.table = g.MakeTable(Options{CellPadding: 4, BorderWidth: 1, BorderStyle: gwu.BrdStyleSolid, BorderColor: gwu.ClrSilver})
+	av.win.Add(av.table)
+	av.refresh()
+
+	return av
+}
+
+// refresh rebuilds the session table from the tracked sessions.
+func (av *adminView) refresh() {
+	sessions := av.tracker.list()
+	av.count.SetText("(" + strconv.Itoa(len(sessions)) + ")")
+
+	for _, child := range childComps(av.table) {
+		av.g.ForgetTree(child)
+	}
+	av.table.Clear()
+	for col, header := range []string{"ID", "Created", "Last access", "Timeout", "Windows", ""} {
+		av.table.Add(av.g.MakeLabel(header, Options{Bold: true}), 0, col)
+	}
+	for i, sess := range sessions {
+		row := i + 1
+		av.table.Add(av.g.MakeLabel(sess.ID(), Options{}), row, 0)
+		av.table.Add(av.g.MakeLabel(sess.Created().Format(AdminTimeFormat), Options{}), row, 1)
+		av.table.Add(av.g.MakeLabel(sess.Accessed().Format(AdminTimeFormat), Options{}), row, 2)
+		av.table.Add(av.g.MakeLabel(sess.Timeout().String(), Options{}), row, 3)
+		av.table.Add(av.g.MakeLabel(strconv.Itoa(len(sess.SortedWins())), Options{}), row, 4)
+
+		invalidate := av.g.MakeButton("Invalidate", Options{})
+		invalidate.AddEHandlerFunc(av.invalidateHandler(sess), gwu.ETypeClick)
+		av.table.Add(invalidate, row, 5)
+	}
+}
+
+func (av *adminView) refreshHandler(e gwu.Event) {
+	av.refresh()
+	e.MarkDirty(av.count, av.table)
+}
+
+func (av *adminView) invalidateHandler(sess gwu.Session) func(gwu.Event) {
+	return func(e gwu.Event) {
+		sess.SetTimeout(time.Nanosecond)
+		av.tracker.Removed(sess)
+		av.refresh()
+		e.MarkDirty(av.count, av.table)
+	}
+}
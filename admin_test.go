@@ -0,0 +1,64 @@
+package wgowut
+
+import (
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+// adminTestSession is a testSession with the times and windows displayed by the admin window.
+type adminTestSession struct {
+	*testSession
+	created time.Time
+	timeout time.Duration
+}
+
+func (s *adminTestSession) Created() time.Time               { return s.created }
+func (s *adminTestSession) Accessed() time.Time              { return s.created.Add(time.Minute) }
+func (s *adminTestSession) Timeout() time.Duration           { return s.timeout }
+func (s *adminTestSession) SetTimeout(timeout time.Duration) { s.timeout = timeout }
+func (s *adminTestSession) SortedWins() []gwu.Window         { return nil }
+
+func TestGuiBuilder_MakeAdminWindow(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+	av := g.newAdminView()
+	assert.Equal(t, "admin", av.win.Name())
+	assert.Equal(t, 1, tableRows(av.table)) // header only
+
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	later := &adminTestSession{newTestSession("later"), created.Add(time.Hour), 30 * time.Minute}
+	first := &adminTestSession{newTestSession("first"), created, 30 * time.Minute}
+	av.tracker.Created(later)
+	av.tracker.Created(first)
+
+	e := &testEvent{etype: gwu.ETypeClick}
+	av.refreshHandler(e)
+	assert.Equal(t, []gwu.Comp{av.count, av.table}, e.dirty)
+	assert.Equal(t, "(2)", av.count.Text())
+	assert.Equal(t, 3, tableRows(av.table))
+	for col, want := range []string{"first", "2024-05-01 12:00:00", "2024-05-01 12:01:00", "30m0s", "0"} {
+		assert.Equal(t, want, av.table.CompAt(1, col).(gwu.Label).Text())
+	}
+	assert.Equal(t, "later", av.table.CompAt(2, 0).(gwu.Label).Text())
+
+	av.invalidateHandler(first)(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, time.Nanosecond, first.timeout)
+	assert.Equal(t, "(1)", av.count.Text())
+	assert.Equal(t, "later", av.table.CompAt(1, 0).(gwu.Label).Text())
+
+	av.tracker.Removed(later)
+	av.refresh()
+	assert.Equal(t, "(0)", av.count.Text())
+	assert.Equal(t, 1, tableRows(av.table))
+
+	// the recipes of the old rows are discarded
+	recipes := len(g.recipes)
+	av.refresh()
+	assert.Equal(t, recipes, len(g.recipes))
+
+	// the window is made with a tracker registered with the server
+	assert.Equal(t, "admin", g.MakeAdminWindow(gwu.NewServer("admintest", "")).Name())
+}
@@ -0,0 +1,164 @@
+package wgowut
+
+import (
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// AsyncPollInterval is how often windows check if the tasks started by RunAsync finished.
+const AsyncPollInterval = 250 * time.Millisecond
+
+// AsyncTask is a function run in the background by RunAsync or RunAsyncWithProgress.
+type AsyncTask struct {
+	done     func(result interface{}, err error)
+	progress func(e gwu.Event, percent float64)
+
+	mu       sync.Mutex
+	finished bool
+	result   interface{}
+	err      error
+	percent  float64
+	reported float64 // reported is the percent last passed to progress
+}
+
+// asyncPoller is the timer of a window calling the done functions of the tasks started by RunAsync once they finish.
+type asyncPoller struct {
+	gwu.Timer
+	root  gwu.Panel
+	tasks []*AsyncTask
+}
+
+// RunAsync runs work in a new goroutine, so long operations don't block the session, and calls done with its result
+// and error in the session once it returns. The window of the source of e is re-rendered after done, so done can
+// update any of its components. A timer of the window (added by the first RunAsync in the window) polls for finished
+// tasks every AsyncPollInterval, calling their done functions from its event handler, so done doesn't race with the
+// event handlers of the session. A panic of work is recovered, and done is called with an error made from it. If the source of e is not in a window, work and done are called before RunAsync
+// returns.
+func (g *GuiBuilder) RunAsync(e gwu.Event, work func() (result interface{}, err error),
+	done func(result interface{}, err error)) *AsyncTask {
+	return g.RunAsyncWithProgress(e, func(func(float64)) (interface{}, error) { return work() }, done, nil)
+}
+
+// RunAsyncWithProgress is like RunAsync, with a progress hook: work reports its progress by calling report with a
+// percentage, and progress is called with the last reported percentage when it changed since the last poll, from the
+// event handler of the polling timer, so it can update a progress bar (see MakeProgressBar) and mark it dirty with e.
+// progress may be nil.
+func (g *GuiBuilder) RunAsyncWithProgress(e gwu.Event, work func(report func(percent float64)) (result interface{}, err error),
+	done func(result interface{}, err error), progress func(e gwu.Event, percent float64)) *AsyncTask {
+	task := &AsyncTask{done: done, progress: progress}
+
+	root := eventRoot(e)
+	if root == nil {
+		task.run(work)
+		task.poll(e)
+		return task
+	}
+
+	poller := windowPoller(root)
+	if poller == nil {
+		poller = &asyncPoller{Timer: gwu.NewTimer(AsyncPollInterval), root: root}
+		poller.SetRepeat(true)
+		poller.AddEHandlerFunc(poller.pollHandler, gwu.ETypeStateChange)
+		root.Add(poller)
+		e.MarkDirty(root)
+	} else {
+		e.MarkDirty(poller)
+	}
+	poller.tasks = append(poller.tasks, task)
+	poller.SetActive(true)
+
+	go task.run(work)
+	return task
+}
+
+// run runs work and records its result. A panic of work is recovered and logged with its stack, and finishes the task
+// with an error made from the panic value, so it doesn't crash the process.
+func (t *AsyncTask) run(work func(report func(percent float64)) (result interface{}, err error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("wgowut: panic in async task: %v\n%s", r, debug.Stack())
+			t.finish(nil, fmt.Errorf("wgowut: async task panicked: %v", r))
+		}
+	}()
+	t.finish(work(t.report))
+}
+
+// Finished tells if the work of the task returned.
+func (t *AsyncTask) Finished() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.finished
+}
+
+// report records the progress of the work of the task.
+func (t *AsyncTask) report(percent float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.percent = percent
+}
+
+// finish records the result of the work of the task.
+func (t *AsyncTask) finish(result interface{}, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.finished, t.result, t.err = true, result, err
+}
+
+// poll calls the progress function of the task if the progress changed, and its done function if it finished, which
+// it tells.
+func (t *AsyncTask) poll(e gwu.Event) bool {
+	t.mu.Lock()
+	finished, result, err, percent := t.finished, t.result, t.err, t.percent
+	t.mu.Unlock()
+
+	if t.progress != nil && percent != t.reported {
+		t.reported = percent
+		t.progress(e, percent)
+	}
+	if finished && t.done != nil {
+		t.done(result, err)
+	}
+	return finished
+}
+
+// windowPoller returns the async poller of the window of root, or nil.
+func windowPoller(root gwu.Comp) *asyncPoller {
+	var poller *asyncPoller
+	walkComps(root, func(c gwu.Comp) {
+		if p, ok := c.(*asyncPoller); ok && poller == nil {
+			poller = p
+		}
+	})
+	return poller
+}
+
+// pollHandler calls the progress and done functions of the tasks, re-renders the window if a task finished, and stops
+// the timer once all finished.
+func (p *asyncPoller) pollHandler(e gwu.Event) {
+	running := p.tasks[:0]
+	finished := false
+	for _, task := range p.tasks {
+		if task.poll(e) {
+			finished = true
+		} else {
+			running = append(running, task)
+		}
+	}
+	p.tasks = running
+
+	if finished {
+		e.MarkDirty(p.root)
+	}
+	if len(p.tasks) == 0 {
+		p.SetActive(false)
+		e.MarkDirty(p)
+	}
+}
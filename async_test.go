@@ -0,0 +1,114 @@
+package wgowut
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_RunAsync(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "main", Options{})
+	btn := g.MakeButton("Export", Options{})
+	win.Add(btn)
+
+	release := make(chan struct{})
+	reported := make(chan struct{})
+	var results []interface{}
+	var percents []float64
+	e := &testEvent{etype: gwu.ETypeClick, src: btn}
+	task := g.RunAsyncWithProgress(e, func(report func(float64)) (interface{}, error) {
+		report(40)
+		close(reported)
+		<-release
+		return "export.csv", nil
+	}, func(result interface{}, err error) {
+		results = append(results, result, err)
+	}, func(e gwu.Event, percent float64) {
+		percents = append(percents, percent)
+	})
+
+	poller := windowPoller(win)
+	if !assert.NotNil(t, poller) {
+		return
+	}
+	assert.True(t, poller.Active())
+	assert.Equal(t, AsyncPollInterval, poller.Timeout())
+	if assert.Len(t, e.dirty, 1) {
+		assert.Equal(t, win.ID(), e.dirty[0].ID()) // the panel embedded by the window, with the new timer
+	}
+
+	<-reported
+	e = &testEvent{etype: gwu.ETypeStateChange, src: poller}
+	poller.pollHandler(e)
+	assert.Equal(t, []float64{40}, percents)
+	assert.Empty(t, results)
+	assert.Empty(t, e.dirty)
+	assert.True(t, poller.Active())
+
+	// a second task reuses the timer
+	e = &testEvent{etype: gwu.ETypeClick, src: btn}
+	failed := g.RunAsync(e, func() (interface{}, error) { return nil, errors.New("no disk") }, func(result interface{}, err error) {
+		results = append(results, err.Error())
+	})
+	assert.Equal(t, []gwu.Comp{poller}, e.dirty)
+	assert.Equal(t, poller, windowPoller(win))
+
+	close(release)
+	assert.Eventually(t, func() bool { return task.Finished() && failed.Finished() }, time.Second, time.Millisecond)
+	e = &testEvent{etype: gwu.ETypeStateChange, src: poller}
+	poller.pollHandler(e)
+	assert.Equal(t, []interface{}{"export.csv", nil, "no disk"}, results)
+	assert.Equal(t, []float64{40}, percents)
+	assert.False(t, poller.Active())
+	if assert.Len(t, e.dirty, 2) {
+		assert.Equal(t, win.ID(), e.dirty[0].ID())
+		assert.Equal(t, gwu.Comp(poller), e.dirty[1])
+	}
+}
+
+func TestGuiBuilder_RunAsync_noWindow(t *testing.T) {
+	g := &GuiBuilder{}
+	var result interface{}
+	task := g.RunAsync(&testEvent{src: g.MakeButton("x", Options{})}, func() (interface{}, error) { return 42, nil },
+		func(r interface{}, err error) { result = r })
+
+	assert.True(t, task.Finished())
+	assert.Equal(t, 42, result)
+}
+
+func TestGuiBuilder_RunAsync_panic(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "main", Options{})
+	btn := g.MakeButton("Export", Options{})
+	win.Add(btn)
+
+	var results []interface{}
+	task := g.RunAsync(&testEvent{etype: gwu.ETypeClick, src: btn}, func() (interface{}, error) {
+		panic("disk full")
+	}, func(result interface{}, err error) {
+		results = append(results, result, err)
+	})
+
+	for !task.Finished() {
+		time.Sleep(time.Millisecond)
+	}
+	poller := windowPoller(win)
+	poller.pollHandler(&testEvent{etype: gwu.ETypeStateChange, src: poller})
+	if assert.Len(t, results, 2) {
+		assert.Nil(t, results[0])
+		assert.EqualError(t, results[1].(error), "wgowut: async task panicked: disk full")
+	}
+
+	// without a window, the panic is recovered before RunAsync returns
+	results = nil
+	task = g.RunAsync(&testEvent{src: g.MakeButton("x", Options{})}, func() (interface{}, error) { panic("boom") },
+		func(result interface{}, err error) { results = append(results, result, err) })
+	assert.True(t, task.Finished())
+	if assert.Len(t, results, 2) {
+		assert.EqualError(t, results[1].(error), "wgowut: async task panicked: boom")
+	}
+}
@@ -0,0 +1,110 @@
+package wgowut
+
+import (
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// AutocompleteRows is the number of suggestions visible at once in the list of an AutocompleteBox.
+const AutocompleteRows = 6
+
+// AutocompleteBox is a gwu.Panel created by GuiBuilder.MakeAutocompleteBox holding a text box and the list of
+// suggestions floating below it.
+type AutocompleteBox struct {
+	gwu.Panel
+
+	suggest     func(prefix string) []string
+	onSelect    func(e gwu.Event, value string)
+	tb          gwu.TextBox
+	suggestions gwu.ListBox
+}
+
+// MakeAutocompleteBox creates an AutocompleteBox. As the user types, suggest is called with the trimmed text and the
+// returned values are listed below the text box; clicking one sets the text box to it and closes the list. The down
+// arrow key moves the focus to the list, escape and enter close it. The following options are used for the text box:
+//
+// WhiteSpace, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, Enable, AutoFocus
+func (g *GuiBuilder) MakeAutocompleteBox(suggest func(prefix string) []string, options Options) *AutocompleteBox {
+	ab := &AutocompleteBox{
+		Panel:       g.MakePanel(Options{Layout: LayoutVertical}),
+		suggest:     suggest,
+		tb:          g.MakeTextBox("", options),
+		suggestions: g.MakeListBox(nil, Options{Rows: AutocompleteRows}),
+	}
+
+	ab.Style().Set("position", "relative")
+	ab.tb.SetAttr("autocomplete", "off")
+	ab.tb.AddSyncOnETypes(gwu.ETypeKeyUp)
+	ab.tb.AddEHandlerFunc(ab.handleKeyUp, gwu.ETypeKeyUp)
+
+	ab.suggestions.Style().Set("position", "absolute").Set("z-index", "100").SetDisplay("none")
+	if options.Width != "" {
+		ab.suggestions.Style().SetWidth(options.Width)
+	}
+	ab.suggestions.AddEHandlerFunc(ab.handleSelect, gwu.ETypeChange)
+
+	g.AddCompsToPanel(ab.Panel, ab.tb, ab.suggestions)
+
+	return ab
+}
+
+// TextBox returns the text box of the autocomplete box.
+func (ab *AutocompleteBox) TextBox() gwu.TextBox {
+	return ab.tb
+}
+
+// Text returns the text of the text box.
+func (ab *AutocompleteBox) Text() string {
+	return ab.tb.Text()
+}
+
+// OnSelect sets the function called with the suggestion the user selected, after the text box is set to it.
+func (ab *AutocompleteBox) OnSelect(fn func(e gwu.Event, value string)) {
+	ab.onSelect = fn
+}
+
+func (ab *AutocompleteBox) handleKeyUp(e gwu.Event) {
+	switch e.KeyCode() {
+	case gwu.KeyDown:
+		if len(ab.suggestions.Values()) > 0 {
+			e.SetFocusedComp(ab.suggestions)
+		}
+		return
+	case gwu.KeyEscape, gwu.KeyEnter:
+		ab.showSuggestions(nil, e)
+		return
+	}
+
+	var values []string
+	if text := strings.TrimSpace(ab.tb.Text()); text != "" {
+		values = ab.suggest(text)
+	}
+	ab.showSuggestions(values, e)
+}
+
+func (ab *AutocompleteBox) handleSelect(e gwu.Event) {
+	value := ab.suggestions.SelectedValue()
+	if value == "" {
+		return
+	}
+
+	ab.tb.SetText(value)
+	ab.showSuggestions(nil, e)
+	e.MarkDirty(ab.tb)
+	e.SetFocusedComp(ab.tb)
+	if ab.onSelect != nil {
+		ab.onSelect(e, value)
+	}
+}
+
+// showSuggestions lists values below the text box, hiding the list if there are none.
+func (ab *AutocompleteBox) showSuggestions(values []string, e gwu.Event) {
+	ab.suggestions.SetValues(values)
+	if len(values) == 0 {
+		ab.suggestions.Style().SetDisplay("none")
+	} else {
+		ab.suggestions.Style().SetDisplay("")
+	}
+	e.MarkDirty(ab.suggestions)
+}
@@ -0,0 +1,62 @@
+package wgowut
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeAutocompleteBox(t *testing.T) {
+	g := &GuiBuilder{}
+	cities := []string{"Berlin", "Bern", "Boston", "Madrid"}
+	ab := g.MakeAutocompleteBox(func(prefix string) []string {
+		var matches []string
+		for _, city := range cities {
+			if strings.HasPrefix(strings.ToLower(city), strings.ToLower(prefix)) {
+				matches = append(matches, city)
+			}
+		}
+		return matches
+	}, Options{Width: "200px"})
+	var selected string
+	ab.OnSelect(func(e gwu.Event, value string) { selected = value })
+
+	assert.Equal(t, "200px", ab.TextBox().Style().Width())
+	assert.Equal(t, "200px", ab.suggestions.Style().Width())
+	assert.Equal(t, "none", ab.suggestions.Style().Display())
+
+	ab.tb.SetText("ber")
+	e := &testEvent{etype: gwu.ETypeKeyUp, src: ab.tb, key: gwu.Key('R')}
+	ab.handleKeyUp(e)
+	assert.Equal(t, []string{"Berlin", "Bern"}, ab.suggestions.Values())
+	assert.Equal(t, "", ab.suggestions.Style().Display())
+	assert.Equal(t, []gwu.Comp{ab.suggestions}, e.dirty)
+
+	// down moves the focus to the suggestions
+	e = &testEvent{etype: gwu.ETypeKeyUp, src: ab.tb, key: gwu.KeyDown}
+	ab.handleKeyUp(e)
+	assert.Equal(t, gwu.Comp(ab.suggestions), e.focused)
+	assert.Empty(t, e.dirty)
+
+	ab.suggestions.SetSelected(1, true)
+	e = &testEvent{etype: gwu.ETypeChange, src: ab.suggestions}
+	ab.handleSelect(e)
+	assert.Equal(t, "Bern", ab.Text())
+	assert.Equal(t, "Bern", selected)
+	assert.Equal(t, "none", ab.suggestions.Style().Display())
+	assert.Empty(t, ab.suggestions.Values())
+	assert.Equal(t, []gwu.Comp{ab.suggestions, ab.tb}, e.dirty)
+	assert.Equal(t, gwu.Comp(ab.tb), e.focused)
+
+	// nothing is suggested for blank text, and escape closes the list
+	ab.tb.SetText("  ")
+	ab.handleKeyUp(&testEvent{etype: gwu.ETypeKeyUp, src: ab.tb, key: gwu.KeySpace})
+	assert.Empty(t, ab.suggestions.Values())
+	ab.tb.SetText("b")
+	ab.handleKeyUp(&testEvent{etype: gwu.ETypeKeyUp, src: ab.tb, key: gwu.Key('B')})
+	assert.Len(t, ab.suggestions.Values(), 3)
+	ab.handleKeyUp(&testEvent{etype: gwu.ETypeKeyUp, src: ab.tb, key: gwu.KeyEscape})
+	assert.Equal(t, "none", ab.suggestions.Style().Display())
+}
@@ -0,0 +1,65 @@
+package wgowut
+
+import (
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// AutoRefresher is the hidden timer refreshing a component periodically, made by AutoRefresh.
+type AutoRefresher struct {
+	gwu.Timer
+	g       *GuiBuilder
+	comp    gwu.Comp
+	refresh func()
+}
+
+// AutoRefresh refreshes comp every interval, for monitoring pages: a hidden timer calls refresh, which updates comp
+// (for example the labels of a panel), the OnRender functions of comp and its descendants are called, and comp is
+// marked dirty. The timer runs from the start; stop and restart it with the Stop and Start methods of the returned
+// refresher. If comp is in a panel, the timer is inserted after it; otherwise the returned refresher has to be added
+// next to comp. refresh may be nil if comp updates itself when rendered.
+func (g *GuiBuilder) AutoRefresh(comp gwu.Comp, interval time.Duration, refresh func()) *AutoRefresher {
+	ar := &AutoRefresher{Timer: gwu.NewTimer(interval), g: g, comp: comp, refresh: refresh}
+	ar.SetRepeat(true)
+	ar.AddEHandlerFunc(ar.tickHandler, gwu.ETypeStateChange)
+
+	if parent, ok := comp.Parent().(gwu.Panel); ok {
+		if idx := parent.CompIdx(comp); idx >= 0 {
+			parent.Insert(ar, idx+1)
+		}
+	}
+
+	return ar
+}
+
+// Start starts refreshing, and marks the timer dirty if e is not nil.
+func (ar *AutoRefresher) Start(e gwu.Event) {
+	ar.setActive(e, true)
+}
+
+// Stop stops refreshing, and marks the timer dirty if e is not nil.
+func (ar *AutoRefresher) Stop(e gwu.Event) {
+	ar.setActive(e, false)
+}
+
+// Running tells if the refresher is refreshing.
+func (ar *AutoRefresher) Running() bool {
+	return ar.Active()
+}
+
+func (ar *AutoRefresher) setActive(e gwu.Event, active bool) {
+	ar.SetActive(active)
+	if e != nil {
+		e.MarkDirty(ar)
+	}
+}
+
+// tickHandler refreshes the component on ticks of the timer.
+func (ar *AutoRefresher) tickHandler(e gwu.Event) {
+	if ar.refresh != nil {
+		ar.refresh()
+	}
+	ar.g.runRenderHooks(ar.comp)
+	e.MarkDirty(ar.comp)
+}
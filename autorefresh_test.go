@@ -0,0 +1,53 @@
+package wgowut
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_AutoRefresh(t *testing.T) {
+	g := &GuiBuilder{}
+	panel := g.MakePanel(Options{})
+	label := g.MakeLabel("0", Options{})
+	g.AddCompsToPanel(panel, label, g.MakeButton("Pause", Options{}))
+
+	ticks := 0
+	ar := g.AutoRefresh(label, 5*time.Second, func() {
+		ticks++
+		label.SetText(strconv.Itoa(ticks))
+	})
+
+	assert.Equal(t, 3, panel.CompsCount())
+	assert.Equal(t, gwu.Comp(ar), panel.CompAt(1))
+	assert.Equal(t, 5*time.Second, ar.Timeout())
+	assert.True(t, ar.Repeat())
+	assert.True(t, ar.Running())
+
+	e := &testEvent{etype: gwu.ETypeStateChange, src: ar}
+	renders := 0
+	g.OnRender(label, func() { renders++ })
+	ar.tickHandler(e)
+	ar.tickHandler(e)
+	assert.Equal(t, "2", label.Text())
+	assert.Equal(t, 2, renders)
+	assert.Equal(t, []gwu.Comp{label, label}, e.dirty)
+
+	e = &testEvent{etype: gwu.ETypeClick}
+	ar.Stop(e)
+	assert.False(t, ar.Running())
+	assert.Equal(t, []gwu.Comp{ar}, e.dirty)
+	ar.Start(nil)
+	assert.True(t, ar.Running())
+
+	// components not in a panel need the refresher added by hand
+	detached := g.MakeLabel("", Options{})
+	ar = g.AutoRefresh(detached, time.Second, nil)
+	assert.Nil(t, ar.Parent())
+	e = &testEvent{etype: gwu.ETypeStateChange, src: ar}
+	ar.tickHandler(e)
+	assert.Equal(t, []gwu.Comp{detached}, e.dirty)
+}
@@ -0,0 +1,38 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// badgeColors holds the text and background colors of badges by variant, matching the notifications of a Notifier.
+var badgeColors = map[Variant][2]string{
+	variantNil:     {"#41464B", "#E2E3E5"},
+	VariantInfo:    {notifyColors[NotifyInfo][0], notifyColors[NotifyInfo][1]},
+	VariantSuccess: {notifyColors[NotifySuccess][0], notifyColors[NotifySuccess][1]},
+	VariantWarning: {notifyColors[NotifyWarning][0], notifyColors[NotifyWarning][1]},
+	VariantError:   {notifyColors[NotifyError][0], notifyColors[NotifyError][1]},
+}
+
+// MakeBadge creates a badge: a small rounded label for statuses and counts, like "active" or "3 new". The Variant
+// option selects its colors (gray without a variant); Color and Background override them. The label is made with
+// MakeLabel using options; FontSize defaults to "85%".
+func (g *GuiBuilder) MakeBadge(text string, options Options) gwu.Label {
+	colors, ok := badgeColors[options.Variant]
+	if !ok {
+		colors = badgeColors[variantNil]
+	}
+	if options.Color == "" {
+		options.Color = colors[0]
+	}
+	if options.Background == "" {
+		options.Background = colors[1]
+	}
+	if options.FontSize == "" {
+		options.FontSize = "85%"
+	}
+	options.WhiteSpace = gwu.WhiteSpaceNowrap
+
+	badge := g.MakeLabel(text, options)
+	badge.Style().SetDisplay("inline-block").SetPadding("2px 8px").Set("border-radius", "10px").Set("line-height", "1.4")
+	return badge
+}
@@ -0,0 +1,40 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeBadge(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    Options
+		color      string
+		background string
+	}{
+		{"neutral", Options{}, badgeColors[variantNil][0], badgeColors[variantNil][1]},
+		{"info", Options{Variant: VariantInfo}, badgeColors[VariantInfo][0], badgeColors[VariantInfo][1]},
+		{"success", Options{Variant: VariantSuccess}, badgeColors[VariantSuccess][0], badgeColors[VariantSuccess][1]},
+		{"warning", Options{Variant: VariantWarning}, badgeColors[VariantWarning][0], badgeColors[VariantWarning][1]},
+		{"error", Options{Variant: VariantError}, badgeColors[VariantError][0], badgeColors[VariantError][1]},
+		{"overridden", Options{Variant: VariantError, Color: gwu.ClrWhite, Background: gwu.ClrBlack}, gwu.ClrWhite, gwu.ClrBlack},
+		{"unknown variant", Options{Variant: Variant(99)}, badgeColors[variantNil][0], badgeColors[variantNil][1]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			badge := g.MakeBadge("active", tt.options)
+
+			assert.Equal(t, "active", badge.Text())
+			assert.Equal(t, tt.color, badge.Style().Color())
+			assert.Equal(t, tt.background, badge.Style().Background())
+			assert.Equal(t, "85%", badge.Style().FontSize())
+			assert.Equal(t, "10px", badge.Style().Get("border-radius"))
+			assert.Equal(t, gwu.WhiteSpaceNowrap, badge.Style().WhiteSpace())
+		})
+	}
+
+	assert.Equal(t, "smaller", (&GuiBuilder{}).MakeBadge("3", Options{FontSize: "smaller"}).Style().FontSize())
+}
@@ -0,0 +1,97 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// Binder keeps components and Go values in sync. The Bind methods register change handlers that update the bound
+// variable when the user changes a component, and Refresh pushes programmatic changes of the variables back to the
+// components.
+type Binder struct {
+	g        *GuiBuilder
+	bindings []binding
+}
+
+// binding holds the functions used to sync a single component and variable. pull updates the variable from the
+// component, push updates the component from the variable and reports if the component changed.
+type binding struct {
+	comp gwu.Comp
+	pull func()
+	push func() bool
+}
+
+// NewBinder returns an empty Binder, running the OnRender functions of g on Refresh.
+func (g *GuiBuilder) NewBinder() *Binder {
+	return &Binder{g: g}
+}
+
+func (b *Binder) add(comp gwu.Comp, pull func(), push func() bool, etype gwu.EventType) {
+	b.bindings = append(b.bindings, binding{comp, pull, push})
+	push()
+	comp.AddEHandlerFunc(func(e gwu.Event) {
+		pull()
+	}, etype)
+}
+
+// BindTextBox binds the text of tb to value. The text box is set to the current value and value is updated on
+// ETypeChange.
+func (b *Binder) BindTextBox(tb gwu.TextBox, value *string) {
+	b.add(tb, func() {
+		*value = tb.Text()
+	}, func() bool {
+		if tb.Text() == *value {
+			return false
+		}
+		tb.SetText(*value)
+		return true
+	}, gwu.ETypeChange)
+}
+
+// BindCheckBox binds the state of cb to value. The check box is set to the current value and value is updated on
+// ETypeClick.
+func (b *Binder) BindCheckBox(cb gwu.CheckBox, value *bool) {
+	b.add(cb, func() {
+		*value = cb.State()
+	}, func() bool {
+		if cb.State() == *value {
+			return false
+		}
+		cb.SetState(*value)
+		return true
+	}, gwu.ETypeClick)
+}
+
+// BindListBox binds the selected value of lb to value. The value is selected in the list box if present (otherwise
+// the selection is cleared) and value is updated on ETypeChange.
+func (b *Binder) BindListBox(lb gwu.ListBox, value *string) {
+	b.add(lb, func() {
+		*value = lb.SelectedValue()
+	}, func() bool {
+		idx := -1
+		for i, v := range lb.Values() {
+			if v == *value {
+				idx = i
+				break
+			}
+		}
+		if lb.SelectedIdx() == idx {
+			return false
+		}
+		lb.ClearSelected()
+		if idx >= 0 {
+			lb.SetSelected(idx, true)
+		}
+		return true
+	}, gwu.ETypeChange)
+}
+
+// Refresh pushes the current values of all bound variables to their components and marks the components that
+// changed dirty, calling their OnRender functions first.
+func (b *Binder) Refresh(e gwu.Event) {
+	for _, bd := range b.bindings {
+		if bd.push() {
+			b.g.runRenderHooks(bd.comp)
+			e.MarkDirty(bd.comp)
+		}
+	}
+}
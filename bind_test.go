@@ -0,0 +1,55 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinder(t *testing.T) {
+	g := &GuiBuilder{}
+	b := g.NewBinder()
+
+	name, enabled, role := "bob", true, "user"
+	tb := g.MakeTextBox("", Options{})
+	cb := gwu.NewCheckBox("enabled")
+	lb := g.MakeListBox([]string{"admin", "user"}, Options{})
+
+	tbHandlers, cbHandlers, lbHandlers := tb.HandlersCount(gwu.ETypeChange), cb.HandlersCount(gwu.ETypeClick), lb.HandlersCount(gwu.ETypeChange)
+	b.BindTextBox(tb, &name)
+	b.BindCheckBox(cb, &enabled)
+	b.BindListBox(lb, &role)
+
+	// initial values are pushed to the comps
+	assert.Equal(t, "bob", tb.Text())
+	assert.Equal(t, true, cb.State())
+	assert.Equal(t, "user", lb.SelectedValue())
+	assert.Equal(t, tbHandlers+1, tb.HandlersCount(gwu.ETypeChange))
+	assert.Equal(t, cbHandlers+1, cb.HandlersCount(gwu.ETypeClick))
+	assert.Equal(t, lbHandlers+1, lb.HandlersCount(gwu.ETypeChange))
+
+	// user changes are pulled into the variables
+	tb.SetText("alice")
+	cb.SetState(false)
+	lb.SetSelectedIndices([]int{0})
+	for _, bd := range b.bindings {
+		bd.pull()
+	}
+	assert.Equal(t, "alice", name)
+	assert.Equal(t, false, enabled)
+	assert.Equal(t, "admin", role)
+
+	// programmatic changes are pushed on refresh, only changed comps are marked dirty
+	name, role = "carol", "missing"
+	e := &testEvent{}
+	b.Refresh(e)
+	assert.Equal(t, "carol", tb.Text())
+	assert.Equal(t, false, cb.State())
+	assert.Equal(t, -1, lb.SelectedIdx())
+	assert.Equal(t, []gwu.Comp{tb, lb}, e.dirty)
+
+	e = &testEvent{}
+	b.Refresh(e)
+	assert.Nil(t, e.dirty)
+}
@@ -71,6 +71,8 @@ package wgowut
 
 import (
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/icza/gowut/gwu"
 )
@@ -103,8 +105,33 @@ const (
 	LayoutVertical
 )
 
-// GuiBuilder is an empty struct that allows convenient access to package functions.
+// Variant is used to set the Variant Option selecting semantic colors
+type Variant int
+
+// Variant option constants
+const (
+	variantNil Variant = iota
+	VariantInfo
+	VariantSuccess
+	VariantWarning
+	VariantError
+)
+
+// GuiBuilder allows convenient access to package functions. Once cloning is enabled (see EnableCloning), it records
+// how each component it makes was built so subtrees can be copied with CloneTree. It must not be copied after first
+// use.
 type GuiBuilder struct {
+	session gwu.Session
+	server  gwu.Server // server is the last server made by the builder with NewServer, or the server of its session
+
+	mu      sync.Mutex
+	cloning bool
+	recipes map[gwu.ID]*recipe
+	hooks   map[gwu.ID][]func()
+	a11y    AccessibilityOptions
+	mobile  MobileOptions
+	topics  map[string][]*subscription
+	created []func(sess gwu.Session, b *GuiBuilder)
 }
 
 // Options implements flags for standard gwu options used while creating components. These options are not required and the
@@ -129,6 +156,29 @@ type Options struct {
 	RowSpan           int
 	Enable            Enable
 	ReadOnly          bool
+	SyncScroll        bool           // SyncScroll keeps the scroll positions of side-by-side panes (e.g. MakeComparePanes) in sync.
+	Sortable          bool           // Sortable makes DataTable columns sortable by clicking their headers.
+	PageSize          int            // PageSize is the number of DataTable rows displayed per page, all rows are displayed if 0.
+	ColumnFilters     bool           // ColumnFilters adds a filter text box under each header of a filterable DataTable.
+	Columns           []ColumnConfig // Columns configures the columns of MakeTableWithHeaders and DataTables by position.
+	StripeBackground  string         // StripeBackground is the background of every other table row, see StripeTable.
+	ColWidths         []string       // ColWidths are the widths of the cells of the table columns by position, "" leaves a column as is.
+	DisableOnClick    bool           // DisableOnClick disables a button in the browser when clicked until the click handlers return.
+	AutoFocus         bool           // AutoFocus focuses a text box, list box or button when its window is loaded, see SetInitialFocus.
+	Bold              bool           // Bold makes the text of a label bold.
+	Exclusive         bool           // Exclusive keeps only one section of an accordion open at a time, see MakeAccordion.
+	Min               float64        // Min is the smallest value of a number box if Max is greater, see MakeNumberBox.
+	Max               float64        // Max is the largest value of a number box if greater than Min.
+	Step              float64        // Step rounds the values of a number box to whole steps from Min if positive.
+	Variant           Variant        // Variant selects the semantic colors of a badge, see MakeBadge.
+	Ratio             int            // Ratio is the percentage of the size taken by the first pane of a split pane, see MakeSplitPane.
+	MaxLines          int            // MaxLines is the number of lines kept by a log viewer, the oldest lines are dropped, see MakeLogViewer.
+
+	// Event handlers of buttons, labels, list boxes and text boxes, added by their Make functions.
+	OnClick        func(gwu.Event) // OnClick is called when the component is clicked.
+	OnChange       func(gwu.Event) // OnChange is called when the value of a list box or text box is changed.
+	OnEnter        func(gwu.Event) // OnEnter is called when Enter is pressed in a text box.
+	DebounceChange time.Duration   // DebounceChange makes a text box send change events once typing pauses for this long.
 }
 
 // NewGuiBuilder returns a GuiBuilder struct.
@@ -138,7 +188,7 @@ func NewGuiBuilder() *GuiBuilder {
 
 // MakeTable creates a gwu.Table and uses the following options:
 //
-// Rows, Cols, CellPadding, HAlign, Valign, Whitespace, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background
+// Rows, Cols, CellPadding, HAlign, Valign, Whitespace, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, StripeBackground, ColWidths
 func (g *GuiBuilder) MakeTable(options Options) gwu.Table {
 	table := gwu.NewTable()
 
@@ -153,7 +203,14 @@ func (g *GuiBuilder) MakeTable(options Options) gwu.Table {
 		table.SetVAlign(options.VAlign)
 	}
 
-	setStyle(table.Style(), options)
+	setStyle(table.Style(), g.styleOptions(options))
+
+	g.record(table, func(g *GuiBuilder) gwu.Comp { return g.MakeTable(options) })
+	g.setColWidths(table, options.ColWidths)
+
+	if options.StripeBackground != "" {
+		g.StripeTable(table, options.StripeBackground)
+	}
 
 	return table
 }
@@ -170,8 +227,7 @@ func setStyle(style gwu.Style, options Options) {
 
 	if options.Height == FullHeight {
 		style.SetFullHeight()
-	}
-	if options.Height != "" {
+	} else if options.Height != "" {
 		style.SetHeight(options.Height)
 	}
 
@@ -219,7 +275,30 @@ func setLayout(pView gwu.PanelView, layout Layout) {
 //
 // CellPadding, HAlign, VAlign, Whitespace, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, ColSpan, RowSpan
 func (g *GuiBuilder) FormatTableCell(table gwu.Table, row, col int, options Options) {
+	g.applyCellFmt(table, row, col, options)
+	g.recordCellFmt(table, row, col, options)
+}
 
+// FormatTableRow formats every existing cell of the given table row with FormatTableCell. ColSpan and RowSpan are
+// applied to every cell too, so they are usually left zero.
+func (g *GuiBuilder) FormatTableRow(table gwu.Table, row int, options Options) {
+	for col, cols := 0, tableCols(table, row); col < cols; col++ {
+		g.FormatTableCell(table, row, col, options)
+	}
+}
+
+// FormatTableColumn formats the cell of the given column in every row of table that has it with FormatTableCell.
+// ColSpan and RowSpan are applied to every cell too, so they are usually left zero.
+func (g *GuiBuilder) FormatTableColumn(table gwu.Table, col int, options Options) {
+	for row, rows := 0, tableRows(table); row < rows; row++ {
+		if col < tableCols(table, row) {
+			g.FormatTableCell(table, row, col, options)
+		}
+	}
+}
+
+// applyCellFmt formats the table cell like FormatTableCell without recording it.
+func (g *GuiBuilder) applyCellFmt(table gwu.Table, row, col int, options Options) {
 	padding := strconv.Itoa(options.CellPadding)
 	table.CellFmt(row, col).Style().SetPadding(padding)
 
@@ -233,15 +312,15 @@ func (g *GuiBuilder) FormatTableCell(table gwu.Table, row, col int, options Opti
 	table.SetColSpan(row, col, options.ColSpan)
 	table.SetRowSpan(row, col, options.RowSpan)
 
-	setStyle(table.CellFmt(row, col).Style(), options)
-
+	setStyle(table.CellFmt(row, col).Style(), g.styleOptions(options))
 }
 
 // MakeListBox takes in a slice of string values, adds them to a ListBox, and sets
 // the first value to the default displayed/selected. The following options are
 // used:
 //
-// Rows, Multi, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, Enable
+// Rows, Multi, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, Enable, AutoFocus,
+// OnClick, OnChange
 func (g *GuiBuilder) MakeListBox(values []string, options Options) gwu.ListBox {
 	lb := gwu.NewListBox(values)
 
@@ -257,9 +336,16 @@ func (g *GuiBuilder) MakeListBox(values []string, options Options) gwu.ListBox {
 
 	setEnabled(lb, options.Enable)
 
-	setStyle(lb.Style(), options)
+	setStyle(lb.Style(), g.styleOptions(options))
 
-	setStyle(lb.Style(), options)
+	setStyle(lb.Style(), g.styleOptions(options))
+	g.enlargeTarget(lb)
+	if options.AutoFocus {
+		autoFocus(lb)
+	}
+	addOptionHandlers(lb, options)
+
+	g.record(lb, func(g *GuiBuilder) gwu.Comp { return g.MakeListBox(values, options) })
 
 	return lb
 }
@@ -268,7 +354,12 @@ func (g *GuiBuilder) MakeListBox(values []string, options Options) gwu.ListBox {
 // Note that the WhiteSpace option is only enforced if Enable is set to false or if ReadOnly is set to True.
 // The following options are used:
 //
-// Rows, Cols, WhiteSpace BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, Enable, ReadOnly.
+// Rows, Cols, WhiteSpace BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, Enable, ReadOnly, AutoFocus,
+// OnClick, OnChange, OnEnter, DebounceChange.
+//
+// With DebounceChange, the text box sends a change event (calling the OnChange and other change handlers) once the user
+// stops typing for the given duration, besides when it loses focus, instead of a round trip per keystroke, for live
+// filters and the like.
 func (g *GuiBuilder) MakeTextBox(text string, options Options) gwu.TextBox {
 	tb := gwu.NewTextBox(text)
 	if options.Rows != 0 {
@@ -282,29 +373,60 @@ func (g *GuiBuilder) MakeTextBox(text string, options Options) gwu.TextBox {
 
 	tb.SetReadOnly(options.ReadOnly)
 
-	setStyle(tb.Style(), options)
+	setStyle(tb.Style(), g.styleOptions(options))
+	g.enlargeTarget(tb)
+	if options.AutoFocus {
+		autoFocus(tb)
+	}
+	addOptionHandlers(tb, options)
+	if options.DebounceChange > 0 {
+		debounceChange(tb, options.DebounceChange)
+	}
+
+	g.record(tb, func(g *GuiBuilder) gwu.Comp { return g.MakeTextBox(text, options) })
 
 	return tb
 }
 
 // MakeLabel creates a label with the given text and uses following options:
 //
-// WhiteSpace, BorderWidth, BorderStyle, BorderColor, FontSize, Color, Background
+// WhiteSpace, BorderWidth, BorderStyle, BorderColor, FontSize, Color, Background, Bold, OnClick
 func (g *GuiBuilder) MakeLabel(text string, options Options) gwu.Label {
 	label := gwu.NewLabel(text)
 
-	setStyle(label.Style(), options)
+	setStyle(label.Style(), g.styleOptions(options))
+	if options.Bold {
+		label.Style().SetFontWeight(gwu.FontWeightBold)
+	}
+	addOptionHandlers(label, options)
+
+	g.record(label, func(g *GuiBuilder) gwu.Comp { return g.MakeLabel(text, options) })
 
 	return label
 }
 
 // MakeButton creates a button with the given text and uses the following options:
 //
-// WhiteSpace, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background
+// WhiteSpace, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, DisableOnClick, AutoFocus,
+// OnClick
+//
+// With DisableOnClick, the button is disabled in the browser as soon as it's clicked and re-rendered (enabled, unless a
+// handler disabled it) with the response of the click event, preventing duplicate backend operations from impatient
+// double clicks.
 func (g *GuiBuilder) MakeButton(text string, options Options) gwu.Button {
 	btn := gwu.NewButton(text)
 
-	setStyle(btn.Style(), options)
+	setStyle(btn.Style(), g.styleOptions(options))
+	g.enlargeTarget(btn)
+	if options.DisableOnClick {
+		disableOnClick(btn)
+	}
+	if options.AutoFocus {
+		autoFocus(btn)
+	}
+	addOptionHandlers(btn, options)
+
+	g.record(btn, func(g *GuiBuilder) gwu.Comp { return g.MakeButton(text, options) })
 
 	return btn
 }
@@ -318,7 +440,8 @@ func (g *GuiBuilder) MakeWindow(name, extension string, options Options) gwu.Win
 
 	setTableView(win, options)
 
-	setStyle(win.Style(), options)
+	setStyle(win.Style(), g.styleOptions(options))
+	g.addMobileHead(win)
 
 	return win
 }
@@ -333,7 +456,12 @@ func (g *GuiBuilder) MakePanel(options Options) gwu.Panel {
 
 	setTableView(panel, options)
 
-	setStyle(panel.Style(), options)
+	setStyle(panel.Style(), g.styleOptions(options))
+	if options.Layout == LayoutHorizontal {
+		g.stackOnMobile(panel)
+	}
+
+	g.record(panel, func(g *GuiBuilder) gwu.Comp { return g.MakePanel(options) })
 
 	return panel
 }
@@ -371,7 +499,29 @@ func (g *GuiBuilder) MakeTabPanel(options Options) gwu.TabPanel {
 
 	setTableView(tabPanel, options)
 
-	setStyle(tabPanel.Style(), options)
+	setStyle(tabPanel.Style(), g.styleOptions(options))
+
+	g.record(tabPanel, func(g *GuiBuilder) gwu.Comp { return g.MakeTabPanel(options) })
 
 	return tabPanel
 }
+
+// SetContainerEnabled recursively walks the children of the container and sets enabled on every gwu.HasEnabled
+// component. Other components without children (e.g. labels) are dimmed and ignore the mouse while disabled.
+func (g *GuiBuilder) SetContainerEnabled(enable bool, container gwu.Container) {
+	walkComps(container, func(comp gwu.Comp) {
+		if hasEnabled, ok := comp.(gwu.HasEnabled); ok {
+			hasEnabled.SetEnabled(enable)
+			return
+		}
+		if comp == container || len(childComps(comp)) != 0 {
+			return
+		}
+
+		if enable {
+			comp.Style().Set("opacity", "").Set("pointer-events", "")
+		} else {
+			comp.Style().Set("opacity", "0.5").Set("pointer-events", "none")
+		}
+	})
+}
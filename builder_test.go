@@ -36,7 +36,11 @@ func checkStyle(t *testing.T, got gwu.Style, options Options) {
 		assert.Equal(t, options.Width, got.Width())
 	}
 
-	assert.Equal(t, options.Height, got.Height())
+	if options.Height == FullHeight {
+		assert.Equal(t, "100%", got.Height())
+	} else {
+		assert.Equal(t, options.Height, got.Height())
+	}
 	assert.Equal(t, options.Color, got.Color())
 	assert.Equal(t, options.Background, got.Background())
 	assert.Equal(t, options.WhiteSpace, got.WhiteSpace())
@@ -64,6 +68,46 @@ func checkPanelView(t *testing.T, got gwu.PanelView, options Options) {
 
 }
 
+// testEvent is a stand-in for the gwu event implementation so event handlers can be called directly in tests.
+type testEvent struct {
+	gwu.Event
+	etype    gwu.EventType
+	src      gwu.Comp
+	key      gwu.Key
+	dirty    []gwu.Comp
+	focused  gwu.Comp
+	reloaded []string
+	sess     gwu.Session
+}
+
+func (e *testEvent) Session() gwu.Session {
+	return e.sess
+}
+
+func (e *testEvent) Type() gwu.EventType {
+	return e.etype
+}
+
+func (e *testEvent) Src() gwu.Comp {
+	return e.src
+}
+
+func (e *testEvent) KeyCode() gwu.Key {
+	return e.key
+}
+
+func (e *testEvent) MarkDirty(comps ...gwu.Comp) {
+	e.dirty = append(e.dirty, comps...)
+}
+
+func (e *testEvent) SetFocusedComp(comp gwu.Comp) {
+	e.focused = comp
+}
+
+func (e *testEvent) ReloadWin(name string) {
+	e.reloaded = append(e.reloaded, name)
+}
+
 func TestNewGuiBuilder(t *testing.T) {
 	tests := []struct {
 		name string
@@ -169,6 +213,34 @@ func TestGuiBuilder_FormatTableCell(t *testing.T) {
 	}
 }
 
+func TestGuiBuilder_FormatTableRowAndColumn(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+	table := g.MakeTable(Options{})
+	table.EnsureCols(0, 3)
+	table.EnsureCols(1, 1)
+	table.EnsureCols(2, 3)
+
+	g.FormatTableRow(table, 0, Options{Background: gwu.ClrSilver, HAlign: gwu.HACenter})
+	for col := 0; col < 3; col++ {
+		assert.Equal(t, gwu.ClrSilver, table.CellFmt(0, col).Style().Background())
+		assert.Equal(t, gwu.HAlign(gwu.HACenter), table.CellFmt(0, col).HAlign())
+	}
+	assert.Equal(t, "", table.CellFmt(2, 0).Style().Background())
+
+	// rows without the column are skipped
+	g.FormatTableColumn(table, 2, Options{Color: gwu.ClrRed})
+	assert.Equal(t, gwu.ClrRed, table.CellFmt(0, 2).Style().Color())
+	assert.Equal(t, gwu.ClrRed, table.CellFmt(2, 2).Style().Color())
+	assert.Nil(t, table.CellFmt(1, 2))
+	assert.Equal(t, "", table.CellFmt(2, 1).Style().Color())
+
+	// the formats are recorded like those of FormatTableCell
+	clone := g.CloneTree(table).(gwu.Table)
+	assert.Equal(t, gwu.ClrSilver, clone.CellFmt(0, 1).Style().Background())
+	assert.Equal(t, gwu.ClrRed, clone.CellFmt(2, 2).Style().Color())
+}
+
 func TestGuiBuilder_MakeListBox(t *testing.T) {
 
 	tests := []struct {
@@ -275,6 +347,7 @@ func TestGuiBuilder_MakeLabel(t *testing.T) {
 			FontSize:    "1",
 			Color:       gwu.ClrMaroon,
 			Background:  gwu.ClrAqua,
+			Bold:        true,
 		}},
 		{"set FullWidth and FullHeight", Options{Width: FullWidth, Height: FullHeight}},
 		{"set no options", Options{}},
@@ -287,6 +360,11 @@ func TestGuiBuilder_MakeLabel(t *testing.T) {
 			assert.Equal(t, tt.name, got.Text())
 
 			checkStyle(t, got.Style(), tt.options)
+			if tt.options.Bold {
+				assert.Equal(t, gwu.FontWeightBold, got.Style().FontWeight())
+			} else {
+				assert.Equal(t, "", got.Style().FontWeight())
+			}
 
 		})
 	}
@@ -517,3 +595,42 @@ func TestGuiBuilder_MakeTabPanel(t *testing.T) {
 		})
 	}
 }
+
+func TestGuiBuilder_SetContainerEnabled(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		enable bool
+	}{
+		{"disable container", false},
+		{"enable container", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			tb := g.MakeTextBox("text", Options{Enable: EnableTrue})
+			lb := g.MakeListBox([]string{"value"}, Options{Enable: EnableTrue})
+			label := g.MakeLabel("label", Options{})
+			table := g.MakeTable(Options{Rows: 1, Cols: 2})
+			table.Add(lb, 0, 0)
+			table.Add(label, 0, 1)
+			panel := g.MakePanel(Options{})
+			g.AddCompsToPanel(panel, tb, table)
+
+			g.SetContainerEnabled(false, panel)
+			g.SetContainerEnabled(tt.enable, panel)
+
+			assert.Equal(t, tt.enable, tb.Enabled())
+			assert.Equal(t, tt.enable, lb.Enabled())
+			if tt.enable {
+				assert.Equal(t, "", label.Style().Get("opacity"))
+				assert.Equal(t, "", label.Style().Get("pointer-events"))
+			} else {
+				assert.Equal(t, "0.5", label.Style().Get("opacity"))
+				assert.Equal(t, "none", label.Style().Get("pointer-events"))
+			}
+			assert.Equal(t, "", table.Style().Get("opacity"))
+			assert.Equal(t, "", panel.Style().Get("opacity"))
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// ButtonSpec declares a button of MakeButtonRow: its text and the handler of its clicks (which may be nil).
+type ButtonSpec struct {
+	Text    string
+	OnClick func(gwu.Event)
+}
+
+// MakeButtonRow creates a panel holding a button for each spec, with the OnClick of the spec added as its ETypeClick
+// handler. The panel is made with MakePanel using the Layout (LayoutHorizontal if not set), CellPadding, HAlign and
+// VAlign options, and the buttons with MakeButton using the rest.
+func (g *GuiBuilder) MakeButtonRow(options Options, buttons ...ButtonSpec) gwu.Panel {
+	panelOptions := Options{Layout: options.Layout, CellPadding: options.CellPadding, HAlign: options.HAlign, VAlign: options.VAlign}
+	if panelOptions.Layout == layoutNil {
+		panelOptions.Layout = LayoutHorizontal
+	}
+	panel := g.MakePanel(panelOptions)
+
+	options.Layout, options.CellPadding, options.HAlign, options.VAlign = layoutNil, 0, "", ""
+	for _, spec := range buttons {
+		btn := g.MakeButton(spec.Text, options)
+		if spec.OnClick != nil {
+			btn.AddEHandlerFunc(spec.OnClick, gwu.ETypeClick)
+		}
+		panel.Add(btn)
+	}
+
+	return panel
+}
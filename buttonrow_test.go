@@ -0,0 +1,45 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeButtonRow(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		layout  gwu.Layout
+	}{
+		{"default layout", Options{CellPadding: 5, HAlign: gwu.HARight, Color: gwu.ClrNavy}, gwu.LayoutHorizontal},
+		{"vertical layout", Options{Layout: LayoutVertical, Background: gwu.ClrSilver}, gwu.LayoutVertical},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			panel := g.MakeButtonRow(tt.options,
+				ButtonSpec{Text: "Save", OnClick: func(e gwu.Event) {}},
+				ButtonSpec{Text: "Cancel"},
+			)
+
+			assert.Equal(t, tt.layout, panel.Layout())
+			assert.Equal(t, tt.options.CellPadding, panel.CellPadding())
+			assert.Equal(t, tt.options.HAlign, panel.HAlign())
+			assert.Equal(t, "", panel.Style().Color())
+			assert.Equal(t, 2, panel.CompsCount())
+
+			for i, want := range []struct {
+				text     string
+				handlers int
+			}{{"Save", 1}, {"Cancel", 0}} {
+				btn := panel.CompAt(i).(gwu.Button)
+				assert.Equal(t, want.text, btn.Text())
+				assert.Equal(t, want.handlers, btn.HandlersCount(gwu.ETypeClick))
+				assert.Equal(t, tt.options.Color, btn.Style().Color())
+				assert.Equal(t, tt.options.Background, btn.Style().Background())
+			}
+		})
+	}
+}
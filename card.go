@@ -0,0 +1,44 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// CardPadding is the default cell padding of the bodies of cards made by MakeCard.
+const CardPadding = 8
+
+// MakeCard creates a card: a bordered vertical panel with a bold title over a body panel holding content, the
+// standard visual grouping of related components. The title is left out if empty. The card is made with MakePanel
+// using options with LayoutVertical, except CellPadding, which is used by the body and defaults to CardPadding;
+// Background defaults to ClrWhite, and the card gets a silver border and rounded corners unless a BorderWidth is given.
+// The title can be styled through its label, the first component of the card if there is a title.
+func (g *GuiBuilder) MakeCard(title string, content gwu.Comp, options Options) gwu.Panel {
+	bodyPadding := options.CellPadding
+	if bodyPadding == 0 {
+		bodyPadding = CardPadding
+	}
+	options.Layout, options.CellPadding = LayoutVertical, 0
+	if options.Background == "" {
+		options.Background = gwu.ClrWhite
+	}
+	if options.BorderWidth == 0 {
+		options.BorderWidth, options.BorderStyle, options.BorderColor = 1, gwu.BrdStyleSolid, gwu.ClrSilver
+	}
+
+	card := g.MakePanel(options)
+	card.Style().Set("border-radius", "4px").Set("border-collapse", "separate")
+
+	if title != "" {
+		header := g.MakeLabel(title, Options{Bold: true, Background: ToolbarBackground})
+		header.Style().SetDisplay(gwu.DisplayBlock).SetPadding("6px 10px").Set("border-bottom", "1px solid "+gwu.ClrSilver)
+		card.Add(header)
+	}
+
+	body := g.MakePanel(Options{Layout: LayoutVertical, CellPadding: bodyPadding, Width: FullWidth})
+	if content != nil {
+		body.Add(content)
+	}
+	card.Add(body)
+
+	return card
+}
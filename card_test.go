@@ -0,0 +1,37 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeCard(t *testing.T) {
+	g := &GuiBuilder{}
+	content := g.MakeLabel("42 users online", Options{})
+	card := g.MakeCard("Users", content, Options{Width: "300px"})
+
+	assert.Equal(t, gwu.LayoutVertical, card.Layout())
+	assert.Equal(t, 0, card.CellPadding())
+	assert.Equal(t, "300px", card.Style().Width())
+	assert.Equal(t, gwu.ClrWhite, card.Style().Background())
+	assert.Equal(t, "1px solid "+gwu.ClrSilver, card.Style().Border())
+	assert.Equal(t, 2, card.CompsCount())
+
+	header := card.CompAt(0).(gwu.Label)
+	assert.Equal(t, "Users", header.Text())
+	assert.Equal(t, gwu.FontWeightBold, header.Style().FontWeight())
+
+	body := card.CompAt(1).(gwu.Panel)
+	assert.Equal(t, CardPadding, body.CellPadding())
+	assert.Equal(t, gwu.Comp(content), body.CompAt(0))
+
+	card = g.MakeCard("", nil, Options{CellPadding: 2, Background: gwu.ClrSilver, BorderWidth: 2, BorderStyle: gwu.BrdStyleDashed, BorderColor: gwu.ClrGray})
+	assert.Equal(t, 1, card.CompsCount())
+	body = card.CompAt(0).(gwu.Panel)
+	assert.Equal(t, 2, body.CellPadding())
+	assert.Equal(t, 0, body.CompsCount())
+	assert.Equal(t, gwu.ClrSilver, card.Style().Background())
+	assert.Equal(t, "2px dashed "+gwu.ClrGray, card.Style().Border())
+}
@@ -0,0 +1,43 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// Cascade wires the parent's change event to repopulate the child gwu.ListBox with the values returned by loadChild
+// for the parent's selected value, e.g. a country ListBox driving a region ListBox. The child is populated immediately
+// from the parent's current selection. Previously selected child values are kept selected if they are still present
+// after repopulating, otherwise the first value is selected.
+func (g *GuiBuilder) Cascade(parent, child gwu.ListBox, loadChild func(parentValue string) []string) {
+	repopulateChild(parent, child, loadChild)
+
+	parent.AddEHandlerFunc(cascadeHandler(parent, child, loadChild), gwu.ETypeChange)
+}
+
+func cascadeHandler(parent, child gwu.ListBox, loadChild func(parentValue string) []string) func(e gwu.Event) {
+	return func(e gwu.Event) {
+		repopulateChild(parent, child, loadChild)
+		e.MarkDirty(child)
+	}
+}
+
+func repopulateChild(parent, child gwu.ListBox, loadChild func(parentValue string) []string) {
+	previous := make(map[string]bool)
+	for _, value := range child.SelectedValues() {
+		previous[value] = true
+	}
+
+	values := loadChild(parent.SelectedValue())
+	child.SetValues(values)
+
+	kept := false
+	for i, value := range values {
+		if previous[value] && (child.Multi() || !kept) {
+			child.SetSelected(i, true)
+			kept = true
+		}
+	}
+	if !kept && len(values) != 0 {
+		child.SetSelected(0, true)
+	}
+}
@@ -0,0 +1,56 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRegions = map[string][]string{
+	"US": {"East", "West", "North"},
+	"EU": {"North", "South"},
+	"XX": nil,
+}
+
+func TestGuiBuilder_Cascade(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		multi        bool
+		childSelect  []int
+		parentSelect int
+		wantValues   []string
+		wantSelected []string
+	}{
+		{"selection kept when present", false, []int{2}, 1, []string{"North", "South"}, []string{"North"}},
+		{"first value selected when selection missing", false, []int{1}, 1, []string{"North", "South"}, []string{"North"}},
+		{"multiple selections kept", true, []int{0, 1, 2}, 1, []string{"North", "South"}, []string{"North"}},
+		{"no child values", false, []int{0}, 2, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			parent := g.MakeListBox([]string{"US", "EU", "XX"}, Options{})
+			child := g.MakeListBox(nil, Options{Multi: tt.multi})
+			load := func(parentValue string) []string { return testRegions[parentValue] }
+
+			handlers := parent.HandlersCount(gwu.ETypeChange)
+			g.Cascade(parent, child, load)
+			assert.Equal(t, handlers+1, parent.HandlersCount(gwu.ETypeChange))
+			assert.Equal(t, testRegions["US"], child.Values())
+			assert.Equal(t, []string{"East"}, child.SelectedValues())
+
+			child.SetSelectedIndices(tt.childSelect)
+			parent.ClearSelected()
+			parent.SetSelected(tt.parentSelect, true)
+
+			e := &testEvent{etype: gwu.ETypeChange, src: parent}
+			cascadeHandler(parent, child, load)(e)
+
+			assert.Equal(t, tt.wantValues, child.Values())
+			assert.Equal(t, tt.wantSelected, child.SelectedValues())
+			assert.Equal(t, []gwu.Comp{child}, e.dirty)
+		})
+	}
+}
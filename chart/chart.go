@@ -0,0 +1,118 @@
+// Package chart draws bar, line and pie charts of Go data as inline SVG in gwu HTML components, so dashboards built
+// with wgowut need no external charting service or JavaScript library.
+package chart
+
+import (
+	"github.com/ddrake12/wgowut"
+	"github.com/icza/gowut/gwu"
+)
+
+// DefaultColors are the colors of the series of charts (the slices of pie charts) that have no color set, used in
+// order and repeated if there are more series. Replace them at startup to match the look of an application.
+var DefaultColors = []string{"#4A90D9", "#E8743B", "#19A979", "#ED4A7B", "#945ECF", "#13A4B4", "#F2C80F", "#6C8893"}
+
+// Default size of charts, used for the options left blank.
+const (
+	Width  = "400px" // Width is the default width of charts.
+	Height = "250px" // Height is the default height of charts.
+)
+
+// Kind is the kind of a chart.
+type Kind int
+
+// Kinds of charts.
+const (
+	KindBar  Kind = iota // KindBar charts draw a group of bars for each label, one bar for each series.
+	KindLine             // KindLine charts draw a line for each series, through its values at the labels.
+	KindPie              // KindPie charts draw a slice for each positive value of the first series.
+)
+
+// Series is a named series of values, one for each label of the data.
+type Series struct {
+	Name   string
+	Values []float64
+	Color  string // Color is the color of the series, a color of DefaultColors if empty.
+}
+
+// Data is the data displayed by a chart.
+type Data struct {
+	Labels []string // Labels are the labels of the X axis, or of the slices of pie charts.
+	Series []Series
+	XLabel string // XLabel is the label of the X axis, unused by pie charts.
+	YLabel string // YLabel is the label of the Y axis, unused by pie charts.
+}
+
+// Chart is a chart drawn with inline SVG. Create it with MakeBarChart, MakeLineChart or MakePieChart.
+type Chart struct {
+	gwu.HTML
+	kind Kind
+	data Data
+}
+
+// MakeBarChart creates a bar chart of data, with a group of bars for each label. A legend naming the series is
+// displayed above the chart if there are several series. The drawing is stretched to the Width and Height options,
+// which default to the Width and Height constants. The Background, Color (of the texts and axes), FontSize and border
+// options are also used.
+func MakeBarChart(data Data, options wgowut.Options) *Chart {
+	return makeChart(KindBar, data, options)
+}
+
+// MakeLineChart creates a line chart of data, with a line for each series. The options are used like in
+// MakeBarChart.
+func MakeLineChart(data Data, options wgowut.Options) *Chart {
+	return makeChart(KindLine, data, options)
+}
+
+// MakePieChart creates a pie chart of the first series of data, with a slice for each positive value colored with
+// DefaultColors, and a legend naming the slices by the labels. The options are used like in MakeBarChart.
+func MakePieChart(data Data, options wgowut.Options) *Chart {
+	return makeChart(KindPie, data, options)
+}
+
+// makeChart creates a chart of kind displaying data, styled with options.
+func makeChart(kind Kind, data Data, options wgowut.Options) *Chart {
+	c := &Chart{HTML: gwu.NewHTML(""), kind: kind}
+	if options.Width == "" {
+		options.Width = Width
+	}
+	if options.Height == "" {
+		options.Height = Height
+	}
+
+	style := c.Style()
+	style.SetDisplay("inline-block").SetWidth(options.Width).SetHeight(options.Height)
+	style.SetBorder2(options.BorderWidth, options.BorderStyle, options.BorderColor)
+	style.SetColor(options.Color).SetBackground(options.Background).SetFontSize(options.FontSize)
+	c.SetAttr("role", "img")
+
+	c.Update(nil, data)
+	return c
+}
+
+// Kind returns the kind of the chart.
+func (c *Chart) Kind() Kind {
+	return c.kind
+}
+
+// Data returns the data displayed by the chart.
+func (c *Chart) Data() Data {
+	return c.data
+}
+
+// Update redraws the chart with data, and marks the chart dirty if e is not nil.
+func (c *Chart) Update(e gwu.Event, data Data) {
+	c.data = data
+	c.SetHTML(render(c.kind, data))
+
+	if e != nil {
+		e.MarkDirty(c)
+	}
+}
+
+// color returns the color of the ith series (or slice).
+func (s Series) color(i int) string {
+	if s.Color != "" {
+		return s.Color
+	}
+	return DefaultColors[i%len(DefaultColors)]
+}
@@ -0,0 +1,106 @@
+package chart
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ddrake12/wgowut"
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+// testEvent is a stand-in for the gwu event implementation recording the components marked dirty.
+type testEvent struct {
+	gwu.Event
+	dirty []gwu.Comp
+}
+
+func (e *testEvent) MarkDirty(comps ...gwu.Comp) {
+	e.dirty = append(e.dirty, comps...)
+}
+
+var sales = Data{
+	Labels: []string{"Q1", "Q2", "Q3"},
+	Series: []Series{{Name: "2023", Values: []float64{10, 20, 30}}, {Name: "2024", Values: []float64{15, 25, 45}, Color: "#123456"}},
+	XLabel: "Quarter",
+	YLabel: "Sales",
+}
+
+func TestMakeBarChart(t *testing.T) {
+	c := MakeBarChart(sales, wgowut.Options{Background: gwu.ClrWhite, FontSize: "90%"})
+
+	assert.Equal(t, KindBar, c.Kind())
+	assert.Equal(t, sales, c.Data())
+	assert.Equal(t, Width, c.Style().Width())
+	assert.Equal(t, Height, c.Style().Height())
+	assert.Equal(t, gwu.ClrWhite, c.Style().Background())
+	assert.Equal(t, "90%", c.Style().FontSize())
+	assert.Equal(t, "img", c.Attr("role"))
+
+	svg := c.HTML.HTML()
+	assert.True(t, strings.HasPrefix(svg, "<svg ") && strings.HasSuffix(svg, "</svg>"))
+	assert.Equal(t, 6, strings.Count(svg, "<rect x=")-2) // 2 legend boxes
+	assert.Contains(t, svg, `>2023</text>`)
+	assert.Contains(t, svg, `fill="#123456"><title>2024 Q3: 45</title>`)
+	assert.Contains(t, svg, `fill="`+DefaultColors[0]+`"><title>2023 Q1: 10</title>`)
+	assert.Contains(t, svg, `>Q2</text>`)
+	assert.Contains(t, svg, `>Quarter</text>`)
+	assert.Contains(t, svg, `transform="rotate(-90 `)
+	assert.Contains(t, svg, `text-anchor="end">50</text>`) // the Y axis is extended to the next tick
+
+	e := &testEvent{}
+	single := Data{Labels: []string{"a", "b"}, Series: []Series{{Name: "x", Values: []float64{-1, 2}}}}
+	c.Update(e, single)
+	assert.Equal(t, []gwu.Comp{c}, e.dirty)
+	assert.Equal(t, single, c.Data())
+	assert.NotContains(t, c.HTML.HTML(), `>x</text>`) // no legend for a single series
+	assert.Contains(t, c.HTML.HTML(), `text-anchor="end">-1</text>`)
+	assert.NotContains(t, c.HTML.HTML(), `rotate(-90`)
+}
+
+func TestMakeLineChart(t *testing.T) {
+	c := MakeLineChart(sales, wgowut.Options{Width: "100%", Height: "300px", Color: gwu.ClrGray})
+
+	assert.Equal(t, KindLine, c.Kind())
+	assert.Equal(t, "100%", c.Style().Width())
+	assert.Equal(t, "300px", c.Style().Height())
+	assert.Equal(t, gwu.ClrGray, c.Style().Color())
+	svg := c.HTML.HTML()
+	assert.Equal(t, 2, strings.Count(svg, "<polyline "))
+	assert.Equal(t, 6, strings.Count(svg, "<circle "))
+	assert.Contains(t, svg, `<title>2024 Q2: 25</title>`)
+
+	c.Update(nil, Data{})
+	assert.NotContains(t, c.HTML.HTML(), "<polyline ")
+}
+
+func TestMakePieChart(t *testing.T) {
+	c := MakePieChart(Data{Labels: []string{"ok", "failed", "none"}, Series: []Series{{Values: []float64{3, 1, 0}}}}, wgowut.Options{})
+
+	assert.Equal(t, KindPie, c.Kind())
+	svg := c.HTML.HTML()
+	assert.Equal(t, 2, strings.Count(svg, "<path "))
+	assert.Contains(t, svg, `>failed</text>`)
+	assert.NotContains(t, svg, `>none</text>`)
+	assert.Contains(t, svg, `<title>ok: 3 (75%)</title>`)
+	assert.Contains(t, svg, ` 0 1 1 `) // the 75% slice is the large arc
+
+	c.Update(nil, Data{Labels: []string{"all"}, Series: []Series{{Values: []float64{5}}}})
+	assert.Contains(t, c.HTML.HTML(), `<title>all: 5 (100%)</title></circle>`)
+	c.Update(nil, Data{})
+	assert.NotContains(t, c.HTML.HTML(), "<path ")
+}
+
+func TestTickStep(t *testing.T) {
+	tests := []struct {
+		lo, hi, want float64
+	}{
+		{0, 45, 10},
+		{0, 1, 0.2},
+		{-1, 2, 1},
+		{0, 7000, 2000},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tickStep(tt.lo, tt.hi))
+	}
+}
@@ -0,0 +1,294 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+)
+
+// Size of the SVG view box of charts, stretched to the size of the chart.
+const (
+	viewWidth  = 400
+	viewHeight = 250
+)
+
+// Parts of the view box of charts.
+const (
+	legendHeight = 20 // legendHeight is the height of the legend above the plot.
+	margin       = 10 // margin is the space around the plot.
+	tickWidth    = 35 // tickWidth is the width of the tick labels left of the Y axis.
+	tickHeight   = 15 // tickHeight is the height of the labels below the X axis.
+	axisLabel    = 15 // axisLabel is the size of the labels of the axes.
+	yTicks       = 5  // yTicks is the preferred number of ticks on the Y axis.
+)
+
+// plot is the rectangle of the plot area of a chart in the view box.
+type plot struct {
+	x, y, w, h float64
+	lo, hi     float64 // lo and hi are the values at the bottom and the top of the Y axis
+}
+
+// valueY returns the vertical position of value in the plot.
+func (p plot) valueY(value float64) float64 {
+	return p.y + p.h - p.h*(value-p.lo)/(p.hi-p.lo)
+}
+
+// render returns the SVG image of a chart of kind displaying data.
+func render(kind Kind, data Data) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg viewBox="0 0 %d %d" width="100%%" height="100%%" preserveAspectRatio="none" `+
+		`font-size="11" font-family="sans-serif" fill="currentColor">`, viewWidth, viewHeight)
+
+	top := float64(margin)
+	if legend := legendEntries(kind, data); len(legend) > 0 {
+		renderLegend(&buf, legend)
+		top += legendHeight
+	}
+
+	if kind == KindPie {
+		renderPie(&buf, data, top)
+	} else {
+		p := newPlot(data, top)
+		renderAxes(&buf, data, p)
+		if kind == KindBar {
+			renderBars(&buf, data, p)
+		} else {
+			renderLines(&buf, data, p)
+		}
+	}
+
+	buf.WriteString("</svg>")
+	return buf.String()
+}
+
+// legendEntry is a colored name of the legend.
+type legendEntry struct {
+	name, color string
+}
+
+// legendEntries returns the entries of the legend of a chart: the series if there are several, or the slices of pie
+// charts.
+func legendEntries(kind Kind, data Data) (entries []legendEntry) {
+	if kind == KindPie {
+		if len(data.Series) > 0 {
+			for i, value := range data.Series[0].Values {
+				if value > 0 && i < len(data.Labels) {
+					entries = append(entries, legendEntry{data.Labels[i], Series{}.color(i)})
+				}
+			}
+		}
+		return
+	}
+
+	if len(data.Series) > 1 {
+		for i, s := range data.Series {
+			entries = append(entries, legendEntry{s.Name, s.color(i)})
+		}
+	}
+	return
+}
+
+// renderLegend draws the legend entries next to each other at the top of the chart.
+func renderLegend(buf *bytes.Buffer, entries []legendEntry) {
+	x := float64(margin)
+	for _, entry := range entries {
+		fmt.Fprintf(buf, `<rect x="%s" y="%d" width="10" height="10" fill="%s"/>`, num(x), margin, html.EscapeString(entry.color))
+		fmt.Fprintf(buf, `<text x="%s" y="%d">%s</text>`, num(x+14), margin+9, html.EscapeString(entry.name))
+		x += 14 + 7*float64(len([]rune(entry.name))) + 12
+	}
+}
+
+// newPlot returns the plot area of a bar or line chart of data below top, with a Y axis covering the values of data
+// and 0, extended to whole ticks.
+func newPlot(data Data, top float64) plot {
+	p := plot{x: margin + tickWidth, y: top}
+	if data.YLabel != "" {
+		p.x += axisLabel
+	}
+	p.w = viewWidth - margin - p.x
+	p.h = viewHeight - margin - tickHeight - p.y
+	if data.XLabel != "" {
+		p.h -= axisLabel
+	}
+
+	for _, s := range data.Series {
+		for _, value := range s.Values {
+			p.lo, p.hi = math.Min(p.lo, value), math.Max(p.hi, value)
+		}
+	}
+	if p.hi == p.lo {
+		p.hi = p.lo + 1
+	}
+	step := tickStep(p.lo, p.hi)
+	p.lo, p.hi = math.Floor(p.lo/step)*step, math.Ceil(p.hi/step)*step
+	return p
+}
+
+// tickStep returns the distance of the ticks of a Y axis from lo to hi: 1, 2 or 5 times a power of 10, giving about
+// yTicks ticks.
+func tickStep(lo, hi float64) float64 {
+	raw := (hi - lo) / yTicks
+	pow := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 5} {
+		if m*pow >= raw {
+			return m * pow
+		}
+	}
+	return 10 * pow
+}
+
+// renderAxes draws the Y axis with its ticks and grid lines, the labels of the X axis and the labels of the axes.
+func renderAxes(buf *bytes.Buffer, data Data, p plot) {
+	step := tickStep(p.lo, p.hi)
+	for k := 0; p.lo+float64(k)*step <= p.hi+step/2; k++ {
+		value := p.lo + float64(k)*step
+		y := p.valueY(value)
+		fmt.Fprintf(buf, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="currentColor" stroke-opacity="0.15"/>`,
+			num(p.x), num(y), num(p.x+p.w), num(y))
+		fmt.Fprintf(buf, `<text x="%s" y="%s" text-anchor="end">%s</text>`, num(p.x-4), num(y+4), formatValue(value))
+	}
+	fmt.Fprintf(buf, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="currentColor"/>`,
+		num(p.x), num(p.valueY(0)), num(p.x+p.w), num(p.valueY(0)))
+	fmt.Fprintf(buf, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="currentColor"/>`, num(p.x), num(p.y), num(p.x), num(p.y+p.h))
+
+	for i, label := range data.Labels {
+		fmt.Fprintf(buf, `<text x="%s" y="%s" text-anchor="middle">%s</text>`,
+			num(labelX(p, len(data.Labels), i, true)), num(p.y+p.h+tickHeight-3), html.EscapeString(label))
+	}
+
+	if data.XLabel != "" {
+		fmt.Fprintf(buf, `<text x="%s" y="%d" text-anchor="middle">%s</text>`,
+			num(p.x+p.w/2), viewHeight-margin, html.EscapeString(data.XLabel))
+	}
+	if data.YLabel != "" {
+		x, y := float64(margin+axisLabel-3), p.y+p.h/2
+		fmt.Fprintf(buf, `<text x="%s" y="%s" text-anchor="middle" transform="rotate(-90 %s %s)">%s</text>`,
+			num(x), num(y), num(x), num(y), html.EscapeString(data.YLabel))
+	}
+}
+
+// labelX returns the horizontal position of the ith of n labels in the plot: the middle of its bar group if bars, or
+// the point of its values in line charts.
+func labelX(p plot, n, i int, bars bool) float64 {
+	if bars {
+		return p.x + p.w*(float64(i)+0.5)/float64(n)
+	}
+	if n == 1 {
+		return p.x + p.w/2
+	}
+	return p.x + p.w*float64(i)/float64(n-1)
+}
+
+// renderBars draws a group of bars for each label, from 0 to the values of the series.
+func renderBars(buf *bytes.Buffer, data Data, p plot) {
+	if len(data.Labels) == 0 || len(data.Series) == 0 {
+		return
+	}
+	group := p.w / float64(len(data.Labels))
+	width := group * 0.8 / float64(len(data.Series))
+	for i := range data.Labels {
+		for j, s := range data.Series {
+			if i >= len(s.Values) {
+				continue
+			}
+			x := p.x + group*float64(i) + group*0.1 + width*float64(j)
+			y0, y1 := p.valueY(0), p.valueY(s.Values[i])
+			fmt.Fprintf(buf, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"><title>%s</title></rect>`,
+				num(x), num(math.Min(y0, y1)), num(width), num(math.Abs(y1-y0)), html.EscapeString(s.color(j)),
+				html.EscapeString(tooltip(s.Name, data.Labels[i], s.Values[i])))
+		}
+	}
+}
+
+// renderLines draws a line through the values of each series, with a dot at each value.
+func renderLines(buf *bytes.Buffer, data Data, p plot) {
+	for j, s := range data.Series {
+		color := html.EscapeString(s.color(j))
+		points := ""
+		for i, value := range s.Values {
+			if i >= len(data.Labels) {
+				break
+			}
+			x, y := labelX(p, len(data.Labels), i, false), p.valueY(value)
+			points += num(x) + "," + num(y) + " "
+			fmt.Fprintf(buf, `<circle cx="%s" cy="%s" r="3" fill="%s"><title>%s</title></circle>`,
+				num(x), num(y), color, html.EscapeString(tooltip(s.Name, data.Labels[i], value)))
+		}
+		if points != "" {
+			fmt.Fprintf(buf, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`, points[:len(points)-1], color)
+		}
+	}
+}
+
+// renderPie draws a slice for each positive value of the first series of data, in a circle below top.
+func renderPie(buf *bytes.Buffer, data Data, top float64) {
+	if len(data.Series) == 0 {
+		return
+	}
+	values := data.Series[0].Values
+	total := 0.0
+	for _, value := range values {
+		if value > 0 {
+			total += value
+		}
+	}
+	if total == 0 {
+		return
+	}
+
+	r := (viewHeight-margin-top)/2 - 1
+	cx, cy := float64(viewWidth)/2, top+r
+	angle := -math.Pi / 2 // slices start at the top and go clockwise
+	for i, value := range values {
+		if value <= 0 {
+			continue
+		}
+		label := ""
+		if i < len(data.Labels) {
+			label = data.Labels[i]
+		}
+		color := html.EscapeString(Series{}.color(i))
+		title := html.EscapeString(tooltip(label, "", value) + fmt.Sprintf(" (%s%%)", num(100*value/total)))
+
+		if value == total {
+			fmt.Fprintf(buf, `<circle cx="%s" cy="%s" r="%s" fill="%s"><title>%s</title></circle>`, num(cx), num(cy), num(r), color, title)
+			return
+		}
+		end := angle + 2*math.Pi*value/total
+		large := 0
+		if end-angle > math.Pi {
+			large = 1
+		}
+		fmt.Fprintf(buf, `<path d="M%s %s L%s %s A%s %s 0 %d 1 %s %s Z" fill="%s"><title>%s</title></path>`,
+			num(cx), num(cy), num(cx+r*math.Cos(angle)), num(cy+r*math.Sin(angle)), num(r), num(r), large,
+			num(cx+r*math.Cos(end)), num(cy+r*math.Sin(end)), color, title)
+		angle = end
+	}
+}
+
+// tooltip returns the text displayed when hovering over a value: "name label: value" without the empty parts.
+func tooltip(name, label string, value float64) string {
+	text := name
+	if label != "" {
+		if text != "" {
+			text += " "
+		}
+		text += label
+	}
+	if text != "" {
+		text += ": "
+	}
+	return text + formatValue(value)
+}
+
+// num formats a coordinate or value with at most 2 decimals, without trailing zeros.
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
+
+// formatValue formats a value of the data, rounded to 10 significant digits to hide floating point errors.
+func formatValue(f float64) string {
+	return strconv.FormatFloat(f, 'g', 10, 64)
+}
@@ -0,0 +1,198 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// recipe records how a component was made by a GuiBuilder: the function making a new, empty copy of it and, for
+// tables, the FormatTableCell calls made on it, the options set with SetTableCellDefaults, whether it has a header and
+// the columns of MakeTableWithHeaders, stripe background, column widths and merged cells.
+type recipe struct {
+	build        func(g *GuiBuilder) gwu.Comp
+	cellFmts     []cellFmt
+	cellDefaults *Options // cellDefaults are the options of the cells of rows added with AddTableRow
+	header       bool     // header is set if row 0 was filled by SetTableHeader
+	columns      []ColumnConfig
+	stripe       string   // stripe is the background set with StripeTable
+	colWidths    []string // colWidths is the ColWidths option of MakeTable
+	merges       []cellRange
+}
+
+type cellFmt struct {
+	row, col int
+	options  Options
+}
+
+// EnableCloning makes g record how the components it makes from now on are built, so they can be copied with
+// CloneTree. Recording keeps the recipe of every component until ForgetTree is called for it, so it is off by default.
+func (g *GuiBuilder) EnableCloning() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.cloning = true
+}
+
+// record registers the recipe of a component made by g, if cloning is enabled.
+func (g *GuiBuilder) record(comp gwu.Comp, build func(g *GuiBuilder) gwu.Comp) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.cloning {
+		return
+	}
+	if g.recipes == nil {
+		g.recipes = make(map[gwu.ID]*recipe)
+	}
+	g.recipes[comp.ID()] = &recipe{build: build}
+}
+
+// tableRecipe returns the recipe of table. If it has none and create is set, a recipe without a build function is
+// created for it: the formatting AddTableRow and RemoveTableRow keep as rows change is recorded even without cloning,
+// but only for the tables it is used on. g.mu must be held.
+func (g *GuiBuilder) tableRecipe(table gwu.Table, create bool) *recipe {
+	r := g.recipes[table.ID()]
+	if r == nil && create {
+		if g.recipes == nil {
+			g.recipes = make(map[gwu.ID]*recipe)
+		}
+		r = &recipe{}
+		g.recipes[table.ID()] = r
+	}
+	return r
+}
+
+// recordCellFmt adds a FormatTableCell call to the recipe of table.
+func (g *GuiBuilder) recordCellFmt(table gwu.Table, row, col int, options Options) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	r := g.tableRecipe(table, true)
+	r.cellFmts = append(r.cellFmts, cellFmt{row, col, options})
+}
+
+// recipe returns a copy of the recipe of comp, or nil if it has none.
+func (g *GuiBuilder) recipe(comp gwu.Comp) *recipe {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	r := g.recipes[comp.ID()]
+	if r == nil {
+		return nil
+	}
+	return &recipe{
+		build:        r.build,
+		cellFmts:     append([]cellFmt(nil), r.cellFmts...),
+		cellDefaults: r.cellDefaults,
+		header:       r.header,
+		columns:      r.columns,
+		stripe:       r.stripe,
+		colWidths:    r.colWidths,
+		merges:       append([]cellRange(nil), r.merges...),
+	}
+}
+
+// CloneTree reconstructs the subtree rooted at root from the recipes recorded when its components were made by g (the
+// Make functions and FormatTableCell), allowing a template, like a "server row" panel, to be stamped out repeatedly.
+// The current text of labels, buttons and text boxes, the enabled state and list box selections are copied. Event
+// handlers and changes made directly through gwu (other than the above) are not copied, and components not made by g
+// are left out. nil is returned if root wasn't made by g after EnableCloning. Clones are recorded as well, so they can
+// be cloned again.
+//
+// With cloning enabled, g keeps a recipe for every component it makes; call ForgetTree for subtrees that are discarded.
+func (g *GuiBuilder) CloneTree(root gwu.Comp) gwu.Comp {
+	r := g.recipe(root)
+	if r == nil || r.build == nil {
+		return nil
+	}
+	clone := r.build(g)
+	copyState(root, clone)
+
+	switch src := root.(type) {
+	case gwu.Table:
+		dst := clone.(gwu.Table)
+		for row, rows := 0, tableRows(src); row < rows; row++ {
+			cols := tableCols(src, row)
+			dst.EnsureSize(row+1, cols)
+			for col := 0; col < cols; col++ {
+				if child := src.CompAt(row, col); child != nil {
+					if childClone := g.CloneTree(child); childClone != nil {
+						dst.Add(childClone, row, col)
+					}
+				}
+			}
+		}
+		for _, f := range r.cellFmts {
+			g.FormatTableCell(dst, f.row, f.col, f.options)
+		}
+		if r.cellDefaults != nil {
+			g.SetTableCellDefaults(dst, *r.cellDefaults)
+		}
+		g.markTableHeader(dst, r.header)
+		g.setTableColumns(dst, r.columns)
+		if r.stripe != "" {
+			g.StripeTable(dst, r.stripe)
+		}
+		for _, m := range r.merges {
+			g.MergeCells(dst, m.fromRow, m.fromCol, m.toRow, m.toCol)
+		}
+		g.reapplyRowFmts(dst)
+	case gwu.TabPanel:
+		dst := clone.(gwu.TabPanel)
+		for i := 0; i < src.CompsCount(); i++ {
+			tab, content := g.CloneTree(src.TabBar().CompAt(i)), g.CloneTree(src.CompAt(i))
+			if tab != nil && content != nil {
+				dst.Add(tab, content)
+			}
+		}
+	case gwu.Panel:
+		dst := clone.(gwu.Panel)
+		for i := 0; i < src.CompsCount(); i++ {
+			if childClone := g.CloneTree(src.CompAt(i)); childClone != nil {
+				dst.Add(childClone)
+			}
+		}
+	}
+
+	return clone
+}
+
+// copyState copies the state that may have changed since src was made to dst.
+func copyState(src, dst gwu.Comp) {
+	if s, ok := src.(gwu.HasText); ok {
+		dst.(gwu.HasText).SetText(s.Text())
+	}
+	if s, ok := src.(gwu.HasEnabled); ok {
+		dst.(gwu.HasEnabled).SetEnabled(s.Enabled())
+	}
+	if s, ok := src.(gwu.ListBox); ok {
+		d := dst.(gwu.ListBox)
+		d.SetValues(s.Values())
+		d.SetSelectedIndices(s.SelectedIndices())
+	}
+}
+
+// forgetter is implemented by components holding resources outside of the component tree, like the tokens of
+// endpoints, which ForgetTree releases.
+type forgetter interface {
+	forget()
+}
+
+// ForgetTree removes the recipes and OnRender functions of root and all of its descendants, and releases the
+// resources they hold outside of the tree, like the endpoints of download buttons, which should be done when a subtree
+// made by g is discarded. Forgotten components can't be cloned.
+func (g *GuiBuilder) ForgetTree(root gwu.Comp) {
+	var forgetters []forgetter
+	g.mu.Lock()
+	walkComps(root, func(c gwu.Comp) {
+		delete(g.recipes, c.ID())
+		delete(g.hooks, c.ID())
+		if f, ok := c.(forgetter); ok {
+			forgetters = append(forgetters, f)
+		}
+	})
+	g.mu.Unlock()
+
+	for _, f := range forgetters {
+		f.forget()
+	}
+}
@@ -0,0 +1,93 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_CloneTree(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+
+	panel := g.MakePanel(Options{Layout: LayoutHorizontal, Background: "Aqua"})
+	name := g.MakeLabel("server", Options{Color: "Red"})
+	name.SetText("alpha")
+	table := g.MakeTable(Options{Rows: 1, Cols: 2})
+	lb := g.MakeListBox([]string{"up", "down"}, Options{Rows: 1})
+	lb.SetSelected(1, true)
+	lb.SetSelected(0, false)
+	table.Add(lb, 0, 0)
+	btn := g.MakeButton("Restart", Options{})
+	btn.SetEnabled(false)
+	table.Add(btn, 1, 1) // grows the table
+	g.FormatTableCell(table, 1, 1, Options{HAlign: gwu.HARight})
+	tabs := g.MakeTabPanel(Options{})
+	tabs.Add(g.MakeLabel("Logs", Options{}), g.MakeTextBox("log text", Options{Rows: 5}))
+	g.AddCompsToPanel(panel, name, table, tabs, gwu.NewLabel("not made by g"))
+
+	clone, ok := g.CloneTree(panel).(gwu.Panel)
+	assert.True(t, ok)
+	assert.NotEqual(t, panel.ID(), clone.ID())
+	assert.Equal(t, gwu.LayoutHorizontal, clone.Layout())
+	assert.Equal(t, "Aqua", clone.Style().Background())
+	assert.Equal(t, 3, clone.CompsCount())
+
+	cloneName := clone.CompAt(0).(gwu.Label)
+	assert.NotEqual(t, name.ID(), cloneName.ID())
+	assert.Equal(t, "alpha", cloneName.Text())
+	assert.Equal(t, "Red", cloneName.Style().Color())
+
+	cloneTable := clone.CompAt(1).(gwu.Table)
+	assert.Equal(t, 2, tableRows(cloneTable))
+	cloneLb := cloneTable.CompAt(0, 0).(gwu.ListBox)
+	assert.Equal(t, []string{"up", "down"}, cloneLb.Values())
+	assert.Equal(t, []int{1}, cloneLb.SelectedIndices())
+	cloneBtn := cloneTable.CompAt(1, 1).(gwu.Button)
+	assert.Equal(t, "Restart", cloneBtn.Text())
+	assert.False(t, cloneBtn.Enabled())
+	assert.Equal(t, gwu.HAlign(gwu.HARight), cloneTable.CellFmt(1, 1).HAlign())
+
+	cloneTabs := clone.CompAt(2).(gwu.TabPanel)
+	assert.Equal(t, 1, cloneTabs.CompsCount())
+	assert.Equal(t, "Logs", cloneTabs.TabBar().CompAt(0).(gwu.Label).Text())
+	assert.Equal(t, 5, cloneTabs.CompAt(0).(gwu.TextBox).Rows())
+
+	// the original is unchanged and clones can be cloned again
+	assert.Equal(t, 4, panel.CompsCount())
+	assert.NotNil(t, g.CloneTree(clone))
+
+	assert.Nil(t, g.CloneTree(gwu.NewLabel("not made by g")))
+	assert.Nil(t, (&GuiBuilder{}).CloneTree(panel))
+
+	g.ForgetTree(panel)
+	assert.Nil(t, g.CloneTree(panel))
+	assert.Nil(t, g.CloneTree(name))
+	assert.NotNil(t, g.CloneTree(clone))
+}
+
+func TestGuiBuilder_EnableCloning(t *testing.T) {
+	g := &GuiBuilder{}
+	label := g.MakeLabel("not recorded", Options{})
+	table := g.MakeTable(Options{Rows: 1, Cols: 1})
+
+	// without cloning nothing is recorded, until the row formatting of a table is used
+	assert.Empty(t, g.recipes)
+	g.MakeForm(Options{}, Field{Label: "Name", Required: true})
+	assert.Empty(t, g.recipes)
+	assert.Nil(t, g.CloneTree(label))
+	g.StripeTable(table, gwu.ClrGrey)
+	assert.Len(t, g.recipes, 1)
+	assert.Nil(t, g.CloneTree(table))
+	g.AddTableRow(table, g.MakeLabel("row", Options{}))
+	assert.Equal(t, gwu.ClrGrey, table.RowFmt(1).Style().Background())
+
+	g.EnableCloning()
+	label = g.MakeLabel("recorded", Options{})
+	clone := g.CloneTree(label)
+	assert.NotNil(t, clone)
+	g.ForgetTree(label)
+	g.ForgetTree(clone)
+	assert.Len(t, g.recipes, 1)
+}
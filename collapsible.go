@@ -0,0 +1,76 @@
+package wgowut
+
+import (
+	"sync"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// collapsedAttr is the session attribute holding the remembered states of the collapsible panels of the session.
+const collapsedAttr = "wgowut.collapsed"
+
+// collapsedStates are the remembered states of collapsible panels by title: true if collapsed.
+type collapsedStates struct {
+	sync.Mutex
+	byTitle map[string]bool
+}
+
+// MakeCollapsiblePanel creates an expander with title as its bold header and content, expanded initially. With a
+// session builder (see NewSessionBuilder), the expanded or collapsed state is remembered in the session when the user
+// toggles the panel, and panels made later with the same title in the session start in that state, so sections stay
+// as the user left them when windows are rebuilt. The options are used like in MakePanel (except Layout).
+func (g *GuiBuilder) MakeCollapsiblePanel(title string, content gwu.Comp, options Options) gwu.Expander {
+	exp := gwu.NewExpander()
+	setTableView(exp, options)
+	setStyle(exp.Style(), g.styleOptions(options))
+	exp.SetHeader(g.MakeLabel(title, Options{Bold: true}))
+	if content != nil {
+		exp.SetContent(content)
+	}
+
+	states := g.collapsedStates()
+	expanded := true
+	if states != nil {
+		states.Lock()
+		expanded = !states.byTitle[title]
+		states.Unlock()
+	}
+	exp.SetExpanded(expanded)
+	exp.AddEHandlerFunc(rememberCollapsed(states, title, exp), gwu.ETypeStateChange)
+
+	return exp
+}
+
+// collapsedStates returns the remembered states of collapsible panels of the session of g, or nil without a session.
+func (g *GuiBuilder) collapsedStates() *collapsedStates {
+	sess := g.Session()
+	if sess == nil {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if states, ok := sess.Attr(collapsedAttr).(*collapsedStates); ok {
+		return states
+	}
+	states := &collapsedStates{byTitle: make(map[string]bool)}
+	sess.SetAttr(collapsedAttr, states)
+	return states
+}
+
+// rememberCollapsed returns the state change handler of a collapsible panel storing its state in states.
+func rememberCollapsed(states *collapsedStates, title string, exp gwu.Expander) func(gwu.Event) {
+	return func(e gwu.Event) {
+		if states == nil {
+			return
+		}
+		states.Lock()
+		defer states.Unlock()
+
+		if exp.Expanded() {
+			delete(states.byTitle, title)
+		} else {
+			states.byTitle[title] = true
+		}
+	}
+}
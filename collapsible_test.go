@@ -0,0 +1,40 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeCollapsiblePanel(t *testing.T) {
+	sess := newTestSession("a")
+	g := NewSessionBuilder(sess)
+	content := g.MakeLabel("filters", Options{})
+	exp := g.MakeCollapsiblePanel("Filters", content, Options{CellPadding: 2, Background: gwu.ClrSilver})
+
+	assert.True(t, exp.Expanded())
+	assert.Equal(t, "Filters", exp.Header().(gwu.Label).Text())
+	assert.Equal(t, gwu.Comp(content), exp.Content())
+	assert.Equal(t, 2, exp.CellPadding())
+	assert.Equal(t, gwu.ClrSilver, exp.Style().Background())
+
+	// the collapsed state is restored for panels of the same title in the session
+	exp.SetExpanded(false)
+	rememberCollapsed(g.collapsedStates(), "Filters", exp)(&testEvent{etype: gwu.ETypeStateChange, src: exp})
+	assert.False(t, g.MakeCollapsiblePanel("Filters", nil, Options{}).Expanded())
+	assert.True(t, g.MakeCollapsiblePanel("Columns", nil, Options{}).Expanded())
+	assert.True(t, NewSessionBuilder(newTestSession("b")).MakeCollapsiblePanel("Filters", nil, Options{}).Expanded())
+
+	exp.SetExpanded(true)
+	rememberCollapsed(g.collapsedStates(), "Filters", exp)(&testEvent{etype: gwu.ETypeStateChange, src: exp})
+	assert.True(t, g.MakeCollapsiblePanel("Filters", nil, Options{}).Expanded())
+
+	// nothing is remembered without a session
+	g = &GuiBuilder{}
+	exp = g.MakeCollapsiblePanel("Filters", nil, Options{})
+	exp.SetExpanded(false)
+	rememberCollapsed(g.collapsedStates(), "Filters", exp)(&testEvent{etype: gwu.ETypeStateChange, src: exp})
+	assert.True(t, g.MakeCollapsiblePanel("Filters", nil, Options{}).Expanded())
+	assert.Equal(t, 1, exp.HandlersCount(gwu.ETypeStateChange))
+}
@@ -0,0 +1,77 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// ColumnConfig declares the behavior of a table column in one place. The columns of a table are configured by position
+// with the Columns option. Unset fields keep the default behavior, so only the needed fields have to be given.
+type ColumnConfig struct {
+	Header     string     // Header replaces the header of the column.
+	Width      string     // Width is the width of the column, set on its header cell.
+	HAlign     gwu.HAlign // HAlign is the horizontal alignment of the cells of the column.
+	Sortable   bool       // Sortable makes a DataTable column sortable, like the Sortable option does for all columns.
+	Filterable bool       // Filterable adds a column filter text box to a filterable DataTable, like the ColumnFilters option does for all columns.
+	Formatter  string     // Formatter is the name of the formatter of a DataTable column, see RegisterFormatter.
+}
+
+// configureColumns applies the ColumnFilters and Columns options to the columns of a DataTable.
+func configureColumns(columns []dataColumn, options Options) []dataColumn {
+	for col := range columns {
+		column := &columns[col]
+		column.filterable = options.ColumnFilters
+		if col >= len(options.Columns) {
+			continue
+		}
+
+		config := options.Columns[col]
+		if config.Header != "" {
+			column.header = config.Header
+		}
+		column.width = config.Width
+		column.halign = config.HAlign
+		column.sortable = column.sortable || config.Sortable
+		column.filterable = column.filterable || config.Filterable
+		if config.Formatter != "" {
+			column.formatter = config.Formatter
+		}
+	}
+	return columns
+}
+
+// MakeTableWithHeaders creates a gwu.Table with MakeTable and fills its header row with the headers of the Columns
+// option using SetTableHeader with headerOptions; Cols is set from the columns. The Width and HAlign of the columns are
+// set on the header cells, and the cells of the rows added with AddTableRow are aligned with the HAlign of their
+// column. The other ColumnConfig fields only apply to DataTables.
+func (g *GuiBuilder) MakeTableWithHeaders(headerOptions Options, options Options) gwu.Table {
+	options.Cols = len(options.Columns)
+	table := g.MakeTable(options)
+
+	headers := make([]string, len(options.Columns))
+	for col, config := range options.Columns {
+		headers[col] = config.Header
+	}
+	g.SetTableHeader(table, headerOptions, headers...)
+
+	for col, config := range options.Columns {
+		if config.Width == "" && config.HAlign == "" {
+			continue
+		}
+		cellOptions := headerOptions
+		mergeOptions(&cellOptions, Options{Width: config.Width, HAlign: config.HAlign})
+		g.FormatTableCell(table, 0, col, cellOptions)
+	}
+	g.setTableColumns(table, options.Columns)
+
+	return table
+}
+
+// setTableColumns records the columns of a table made with MakeTableWithHeaders.
+func (g *GuiBuilder) setTableColumns(table gwu.Table, columns []ColumnConfig) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if r := g.tableRecipe(table, len(columns) > 0); r != nil {
+		r.columns = columns
+	}
+}
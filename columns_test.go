@@ -0,0 +1,91 @@
+package wgowut
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeDataTable_columns(t *testing.T) {
+	g := &GuiBuilder{}
+	g.RegisterFormatter("testPort", func(v interface{}) string { return fmt.Sprint(":", v) })
+	defer g.RegisterFormatter("testPort", nil)
+
+	columns := []ColumnConfig{
+		{Header: "Host", Width: "200px", Sortable: true},
+		{HAlign: gwu.HARight, Formatter: "testPort", Filterable: true},
+	}
+	dt, err := g.MakeDataTable([]testServer{{Name: "beta", Port: 443}, {Name: "alpha", Port: 80}}, Options{Columns: columns})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"Host", "Port", "Load", "Online", "Tags"}, dt.Headers())
+	assert.Equal(t, "200px", dt.CellFmt(0, 0).Style().Width())
+	assert.Equal(t, gwu.HAlign(gwu.HARight), dt.CellFmt(0, 1).HAlign())
+	assert.Equal(t, gwu.HAlign(gwu.HARight), dt.CellFmt(1, 1).HAlign())
+	assert.Equal(t, gwu.HAlign(gwu.HADefault), dt.CellFmt(1, 0).HAlign())
+	assert.Equal(t, ":443", dt.Cell(0, 1))
+
+	// only configured columns are sortable
+	assert.Equal(t, gwu.CursorPointer, dt.CompAt(0, 0).Style().Cursor())
+	assert.Equal(t, "", dt.CompAt(0, 1).Style().Cursor())
+	dt.sortHandler(0)(&testEvent{})
+	assert.Equal(t, "alpha", dt.Cell(0, 0))
+
+	// cell rules keep the column alignment unless they set one
+	g.AddCellRule(dt, func(row, col int, value string) *Options { return &Options{Color: "Red"} })
+	assert.Equal(t, gwu.HAlign(gwu.HARight), dt.CellFmt(1, 1).HAlign())
+	assert.Equal(t, "Red", dt.CellFmt(1, 1).Style().Color())
+
+	// only configured columns get a filter box
+	ft, err := g.MakeFilterableDataTable([]testServer{{Name: "alpha", Port: 80}, {Name: "beta", Port: 443}}, Options{Columns: columns})
+	assert.NoError(t, err)
+	assert.Nil(t, ft.ColumnFilterBox(0))
+	assert.NotNil(t, ft.ColumnFilterBox(1))
+	assert.Nil(t, ft.Table().CompAt(1, 0))
+	assert.Equal(t, ft.ColumnFilterBox(1), ft.Table().CompAt(1, 1))
+	ft.ColumnFilterBox(1).SetText(":4")
+	ft.applyFilters(&testEvent{})
+	assert.Equal(t, 1, ft.Table().Len())
+
+	// CSV tables are configured as well
+	csv, err := g.LoadTableFromCSV(strings.NewReader("name,port\na,1\n"), Options{Columns: columns[:1]})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Host", "port"}, csv.Headers())
+	assert.NoError(t, csv.ReloadCSV(strings.NewReader("host,port\nb,2\n")))
+	assert.Equal(t, []string{"Host", "port"}, csv.Headers())
+}
+
+func TestGuiBuilder_MakeTableWithHeaders(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+	table := g.MakeTableWithHeaders(Options{Background: "Silver"}, Options{CellPadding: 2, Columns: []ColumnConfig{
+		{Header: "Name", Width: "10em"},
+		{Header: "Port", HAlign: gwu.HARight},
+	}})
+
+	assert.Equal(t, 2, tableCols(table, 0))
+	assert.Equal(t, "Name", table.CompAt(0, 0).(gwu.Label).Text())
+	assert.Equal(t, "Port", table.CompAt(0, 1).(gwu.Label).Text())
+	assert.Equal(t, "10em", table.CellFmt(0, 0).Style().Width())
+	assert.Equal(t, "Silver", table.CellFmt(0, 0).Style().Background())
+	assert.Equal(t, gwu.HAlign(gwu.HARight), table.CellFmt(0, 1).HAlign())
+	assert.Equal(t, "Silver", table.CellFmt(0, 1).Style().Background())
+
+	row := g.AddTableRow(table, g.MakeLabel("alpha", Options{}), g.MakeLabel("80", Options{}))
+	assert.Equal(t, gwu.HAlign(gwu.HADefault), table.CellFmt(row, 0).HAlign())
+	assert.Equal(t, gwu.HAlign(gwu.HARight), table.CellFmt(row, 1).HAlign())
+	assert.Equal(t, "", table.CellFmt(row, 1).Style().Background())
+
+	// the column alignment overrides the cell defaults
+	g.SetTableCellDefaults(table, Options{HAlign: gwu.HACenter, Color: "Navy"})
+	clone := g.CloneTree(table).(gwu.Table)
+	for _, tbl := range []gwu.Table{table, clone} {
+		row = g.AddTableRow(tbl, g.MakeLabel("beta", Options{}), g.MakeLabel("443", Options{}))
+		assert.Equal(t, gwu.HAlign(gwu.HACenter), tbl.CellFmt(row, 0).HAlign())
+		assert.Equal(t, gwu.HAlign(gwu.HARight), tbl.CellFmt(row, 1).HAlign())
+		assert.Equal(t, "Navy", tbl.CellFmt(row, 1).Style().Color())
+	}
+}
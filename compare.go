@@ -0,0 +1,63 @@
+package wgowut
+
+import (
+	"fmt"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// syncScrollJs is the onscroll handler copying a pane's scroll position to the pane with the given id.
+const syncScrollJs = "var o=document.getElementById('%v');if(o){o.scrollTop=this.scrollTop;o.scrollLeft=this.scrollLeft;}"
+
+// MakeComparePanes creates a one row, two column gwu.Table holding the left and right components side by side under
+// their titles, in equally sized panes. The Height option sets the height of the panes, which scroll if their content
+// is larger. If SyncScroll is set, scrolling one pane scrolls the other to the same position. The following options
+// are used for the table:
+//
+// CellPadding, HAlign, Valign, Whitespace, BorderWidth, BorderStyle, BorderColor, Width, FontSize, Color, Background
+func (g *GuiBuilder) MakeComparePanes(leftTitle, rightTitle string, left, right gwu.Comp, options Options) gwu.Table {
+	height := options.Height
+	options.Height = ""
+	options.Rows, options.Cols = 1, 2
+	table := g.MakeTable(options)
+
+	leftPane := makeComparePane(height)
+	leftPane.Add(left)
+	rightPane := makeComparePane(height)
+	rightPane.Add(right)
+
+	if options.SyncScroll {
+		leftPane.SetAttr("onscroll", fmt.Sprintf(syncScrollJs, rightPane.ID()))
+		rightPane.SetAttr("onscroll", fmt.Sprintf(syncScrollJs, leftPane.ID()))
+	}
+
+	for col, pane := range []gwu.Panel{leftPane, rightPane} {
+		title := leftTitle
+		if col == 1 {
+			title = rightTitle
+		}
+		titleLabel := g.MakeLabel(title, Options{})
+		titleLabel.Style().SetFontWeight(gwu.FontWeightBold)
+		pane.SetAttr("role", "region")
+		g.LabelFor(titleLabel, pane)
+
+		column := g.MakePanel(Options{Width: FullWidth})
+		g.AddCompsToPanel(column, titleLabel, pane)
+
+		table.Add(column, 0, col)
+		table.CellFmt(0, col).Style().SetWidth("50%")
+		table.CellFmt(0, col).SetVAlign(gwu.VATop)
+	}
+
+	return table
+}
+
+// makeComparePane returns a natural panel (rendered as a block) that scrolls when its content is larger than height.
+func makeComparePane(height string) gwu.Panel {
+	pane := gwu.NewNaturalPanel()
+	pane.Style().SetDisplay("block").Set("overflow", "auto").SetFullWidth()
+	if height != "" {
+		pane.Style().SetHeight(height)
+	}
+	return pane
+}
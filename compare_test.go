@@ -0,0 +1,57 @@
+package wgowut
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeComparePanes(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		options Options
+	}{
+		{"sync scroll with height", Options{CellPadding: 4, Height: "300px", SyncScroll: true, Width: FullWidth}},
+		{"set no options", Options{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			left, right := g.MakeLabel("before", Options{}), g.MakeLabel("after", Options{})
+			got := g.MakeComparePanes("Before", "After", left, right, tt.options)
+
+			assert.Equal(t, tt.options.CellPadding, got.CellPadding())
+			assert.Equal(t, "", got.Style().Height())
+
+			var panes []gwu.Panel
+			for col, want := range []struct {
+				title string
+				comp  gwu.Comp
+			}{{"Before", left}, {"After", right}} {
+				assert.Equal(t, "50%", got.CellFmt(0, col).Style().Width())
+
+				column := got.CompAt(0, col).(gwu.Panel)
+				assert.Equal(t, want.title, column.CompAt(0).(gwu.Label).Text())
+
+				pane := column.CompAt(1).(gwu.Panel)
+				assert.Equal(t, gwu.LayoutNatural, pane.Layout())
+				assert.Equal(t, tt.options.Height, pane.Style().Height())
+				assert.Equal(t, want.comp, pane.CompAt(0))
+				assert.Equal(t, "region", pane.Attr("role"))
+				assert.Equal(t, column.CompAt(0).ID().String(), pane.Attr("aria-labelledby"))
+				assert.Equal(t, want.title, pane.Attr("aria-label"))
+				panes = append(panes, pane)
+			}
+
+			if tt.options.SyncScroll {
+				assert.Equal(t, fmt.Sprintf(syncScrollJs, panes[1].ID()), panes[0].Attr("onscroll"))
+				assert.Equal(t, fmt.Sprintf(syncScrollJs, panes[0].ID()), panes[1].Attr("onscroll"))
+			} else {
+				assert.Equal(t, "", panes[0].Attr("onscroll"))
+			}
+		})
+	}
+}
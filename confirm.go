@@ -0,0 +1,76 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// confirmDialog is the modal of a window asking the user to confirm an action, shared by the Confirm handlers of the
+// window.
+type confirmDialog struct {
+	gwu.Panel
+	message   gwu.Label
+	no        gwu.Button
+	onConfirm func(gwu.Event)
+}
+
+// windowConfirmDialog returns the confirmation dialog of win, adding it on the first call.
+func (g *GuiBuilder) windowConfirmDialog(win gwu.Window) *confirmDialog {
+	for i := 0; i < win.CompsCount(); i++ {
+		if cd, ok := win.CompAt(i).(*confirmDialog); ok {
+			return cd
+		}
+	}
+
+	cd := &confirmDialog{Panel: g.MakePanel(Options{Layout: LayoutVertical, HAlign: gwu.HACenter, VAlign: gwu.VAMiddle})}
+	cd.Style().Set("position", "fixed").Set("top", "0").Set("left", "0").SetWidth("100%").SetHeight("100%").
+		Set("z-index", "1000").SetBackground("rgba(0,0,0,0.4)").SetDisplay(gwu.DisplayNone)
+
+	box := g.MakePanel(Options{Layout: LayoutVertical, CellPadding: 10, HAlign: gwu.HACenter, Background: gwu.ClrWhite,
+		BorderWidth: 1, BorderStyle: gwu.BrdStyleSolid, BorderColor: gwu.ClrGray})
+	box.Style().Set("min-width", "300px").Set("box-shadow", "0 4px 16px rgba(0,0,0,0.3)")
+	box.SetAttr("role", "alertdialog")
+	box.SetAttr("aria-modal", "true")
+
+	cd.message = g.MakeLabel("", Options{})
+	box.SetAttr("aria-describedby", cd.message.ID().String())
+	buttons := g.MakeButtonRow(Options{CellPadding: 4}, ButtonSpec{"Yes", cd.yesHandler}, ButtonSpec{"No", cd.noHandler})
+	cd.no = buttons.CompAt(1).(gwu.Button)
+	g.AddCompsToPanel(box, cd.message, buttons)
+
+	cd.Add(box)
+	win.Add(cd)
+	return cd
+}
+
+// Confirm returns an event handler asking the user to confirm an action in a yes/no modal over win, so destructive
+// actions (like delete and restart) are never one accidental click away; add it to the component starting the action
+// instead of the action itself:
+//
+//	btn.AddEHandlerFunc(g.Confirm(win, "Delete the selected users?", deleteUsers), gwu.ETypeClick)
+//
+// onConfirm is called with the click event of the Yes button. The modal is added to win by the first call, so call it
+// before the window is rendered. No has the focus when the modal is shown.
+func (g *GuiBuilder) Confirm(win gwu.Window, message string, onConfirm func(gwu.Event)) func(gwu.Event) {
+	cd := g.windowConfirmDialog(win)
+	return func(e gwu.Event) {
+		cd.message.SetText(message)
+		cd.onConfirm = onConfirm
+		cd.Style().SetDisplay("")
+		e.MarkDirty(cd)
+		e.SetFocusedComp(cd.no)
+	}
+}
+
+func (cd *confirmDialog) yesHandler(e gwu.Event) {
+	onConfirm := cd.onConfirm
+	cd.noHandler(e)
+	if onConfirm != nil {
+		onConfirm(e)
+	}
+}
+
+func (cd *confirmDialog) noHandler(e gwu.Event) {
+	cd.onConfirm = nil
+	cd.Style().SetDisplay(gwu.DisplayNone)
+	e.MarkDirty(cd)
+}
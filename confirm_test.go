@@ -0,0 +1,45 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_Confirm(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "Main", Options{})
+	var deleted, restarted int
+	deleteHandler := g.Confirm(win, "Delete the user?", func(gwu.Event) { deleted++ })
+	restartHandler := g.Confirm(win, "Restart the server?", func(gwu.Event) { restarted++ })
+
+	// the handlers share the dialog of the window
+	assert.Equal(t, 1, win.CompsCount())
+	cd := win.CompAt(0).(*confirmDialog)
+	assert.Equal(t, gwu.DisplayNone, cd.Style().Display())
+
+	e := &testEvent{etype: gwu.ETypeClick}
+	deleteHandler(e)
+	assert.Equal(t, "", cd.Style().Display())
+	assert.Equal(t, "Delete the user?", cd.message.Text())
+	assert.Equal(t, []gwu.Comp{cd}, e.dirty)
+	assert.Equal(t, gwu.Comp(cd.no), e.focused)
+
+	cd.noHandler(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, gwu.DisplayNone, cd.Style().Display())
+	assert.Equal(t, 0, deleted)
+
+	restartHandler(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, "Restart the server?", cd.message.Text())
+	e = &testEvent{etype: gwu.ETypeClick}
+	cd.yesHandler(e)
+	assert.Equal(t, gwu.DisplayNone, cd.Style().Display())
+	assert.Equal(t, []gwu.Comp{cd}, e.dirty)
+	assert.Equal(t, 0, deleted)
+	assert.Equal(t, 1, restarted)
+
+	// yes does nothing once the dialog is closed
+	cd.yesHandler(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, 1, restarted)
+}
@@ -0,0 +1,202 @@
+package wgowut
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Console defaults used by MakeConsole for the options left blank.
+const (
+	ConsoleBackground      = "#1E1E1E"   // ConsoleBackground is the default background of consoles.
+	ConsoleColor           = "#D4D4D4"   // ConsoleColor is the default text color of consoles.
+	ConsoleRefreshInterval = time.Second // ConsoleRefreshInterval is how often consoles display the written lines.
+)
+
+// ansiColors are the colors of the 16 basic ANSI color codes: black, red, green, yellow, blue, magenta, cyan and
+// white, then their bright variants.
+var ansiColors = [16]string{
+	"#000000", "#CD3131", "#0DBC79", "#E5E510", "#2472C8", "#BC3FBC", "#11A8CD", "#E5E5E5",
+	"#666666", "#F14C4C", "#23D18B", "#F5F543", "#3B8EEA", "#D670D6", "#29B8DB", "#FFFFFF",
+}
+
+// ansiSeq matches ANSI control sequences; the parameters of "m" (SGR) sequences select colors and weight.
+var ansiSeq = regexp.MustCompile(`\x1b\[([0-9;?]*)([A-Za-z])`)
+
+// Console is a terminal-like pane displaying the output written to it, for example by commands run with os/exec.
+// Create it with MakeConsole.
+type Console struct {
+	*LogViewer
+	mu      sync.Mutex
+	partial []byte // partial is the last written line until its newline is written
+}
+
+// MakeConsole creates an empty console: a log viewer (see MakeLogViewer) implementing io.Writer, keeping the last
+// MaxLines lines of the output written to it. ANSI color codes of the output are displayed as colors, other control
+// sequences are dropped, and a carriage return starts its line over, like progress output in terminals. The console
+// displays the written lines every ConsoleRefreshInterval (see SetRefreshInterval), scrolling to the newest one.
+// Background defaults to ConsoleBackground and Color to ConsoleColor; the other options are used like in
+// MakeLogViewer.
+func (g *GuiBuilder) MakeConsole(options Options) *Console {
+	if options.Background == "" {
+		options.Background = ConsoleBackground
+	}
+	if options.Color == "" {
+		options.Color = ConsoleColor
+	}
+
+	c := &Console{LogViewer: g.MakeLogViewer(options)}
+	c.format = ansiToHTML
+	c.SetRefreshInterval(ConsoleRefreshInterval)
+	return c
+}
+
+// Write appends the lines of p to the console. A line without a newline at the end is kept until the rest of it is
+// written, or Flush is called. It's safe to call from any goroutine, and never returns an error.
+func (c *Console) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.partial = append(c.partial, p...)
+	for {
+		i := bytes.IndexByte(c.partial, '\n')
+		if i < 0 {
+			break
+		}
+		c.appendLine(string(c.partial[:i]))
+		c.partial = c.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// Flush appends the line written without a newline at the end, if any, like a prompt.
+func (c *Console) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.partial) > 0 {
+		c.appendLine(string(c.partial))
+		c.partial = nil
+	}
+}
+
+// appendLine appends the text of line after its last carriage return.
+func (c *Console) appendLine(line string) {
+	line = strings.TrimSuffix(line, "\r")
+	if i := strings.LastIndexByte(line, '\r'); i >= 0 {
+		line = line[i+1:]
+	}
+	c.Append(line)
+}
+
+// ansiStyle is the text style selected by ANSI SGR codes.
+type ansiStyle struct {
+	color, background string
+	bold              bool
+}
+
+// ansiToHTML returns the HTML displaying line with the colors selected by its ANSI codes, dropping other control
+// sequences. The style is reset at the start of each line.
+func ansiToHTML(line string) string {
+	var buf strings.Builder
+	var style ansiStyle
+	open := false
+
+	last := 0
+	for _, m := range ansiSeq.FindAllStringSubmatchIndex(line, -1) {
+		buf.WriteString(html.EscapeString(line[last:m[0]]))
+		last = m[1]
+		if line[m[4]:m[5]] != "m" {
+			continue
+		}
+		if open {
+			buf.WriteString("</span>")
+		}
+		style = style.apply(line[m[2]:m[3]])
+		if open = style != (ansiStyle{}); open {
+			buf.WriteString(style.span())
+		}
+	}
+	buf.WriteString(html.EscapeString(strings.ReplaceAll(line[last:], "\x1b", "")))
+	if open {
+		buf.WriteString("</span>")
+	}
+	return buf.String()
+}
+
+// apply returns the style changed by the semicolon separated SGR codes of params.
+func (s ansiStyle) apply(params string) ansiStyle {
+	codes := strings.Split(params, ";")
+	for i := 0; i < len(codes); i++ {
+		code, _ := strconv.Atoi(codes[i]) // an empty code is 0
+		switch {
+		case code == 0:
+			s = ansiStyle{}
+		case code == 1:
+			s.bold = true
+		case code == 22:
+			s.bold = false
+		case code >= 30 && code <= 37:
+			s.color = ansiColors[code-30]
+		case code >= 90 && code <= 97:
+			s.color = ansiColors[code-90+8]
+		case code == 39:
+			s.color = ""
+		case code >= 40 && code <= 47:
+			s.background = ansiColors[code-40]
+		case code >= 100 && code <= 107:
+			s.background = ansiColors[code-100+8]
+		case code == 49:
+			s.background = ""
+		case code == 38 || code == 48:
+			color, n := extendedColor(codes[i+1:])
+			i += n
+			if code == 38 {
+				s.color = color
+			} else {
+				s.background = color
+			}
+		}
+	}
+	return s
+}
+
+// extendedColor returns the color selected by the codes after a 38 or 48 SGR code ("5;n" for 256 colors or "2;r;g;b"
+// for RGB colors), and the number of codes used. Colors of the 256 color palette beyond the basic ones are not
+// supported, they leave the color unset.
+func extendedColor(codes []string) (string, int) {
+	if len(codes) >= 2 && codes[0] == "5" {
+		if n, err := strconv.Atoi(codes[1]); err == nil && n >= 0 && n < len(ansiColors) {
+			return ansiColors[n], 2
+		}
+		return "", 2
+	}
+	if len(codes) >= 4 && codes[0] == "2" {
+		var rgb [3]int
+		for i := range rgb {
+			rgb[i], _ = strconv.Atoi(codes[i+1])
+		}
+		return fmt.Sprintf("rgb(%d,%d,%d)", rgb[0], rgb[1], rgb[2]), 4
+	}
+	return "", len(codes)
+}
+
+// span returns the opening span tag displaying text in style s.
+func (s ansiStyle) span() string {
+	css := ""
+	if s.color != "" {
+		css += "color:" + s.color + ";"
+	}
+	if s.background != "" {
+		css += "background:" + s.background + ";"
+	}
+	if s.bold {
+		css += "font-weight:bold;"
+	}
+	return `<span style="` + html.EscapeString(css) + `">`
+}
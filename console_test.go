@@ -0,0 +1,62 @@
+package wgowut
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeConsole(t *testing.T) {
+	g := &GuiBuilder{}
+	c := g.MakeConsole(Options{MaxLines: 3})
+
+	assert.Equal(t, ConsoleBackground, c.view.Style().Background())
+	assert.Equal(t, ConsoleColor, c.view.Style().Color())
+	assert.True(t, c.timer.Active())
+	assert.Equal(t, ConsoleRefreshInterval, c.timer.Timeout())
+
+	n, err := fmt.Fprint(c, "building\r\n\x1b[32mok\x1b[0m 1/2")
+	assert.Equal(t, 25, n)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"building"}, c.Lines())
+	fmt.Fprint(c, "\r2/2\n3\n4\n")
+	assert.Equal(t, []string{"2/2", "3", "4"}, c.Lines())
+
+	fmt.Fprint(c, "Continue? ")
+	c.Flush()
+	c.Flush()
+	assert.Equal(t, []string{"3", "4", "Continue? "}, c.Lines())
+
+	c.Clear()
+	fmt.Fprintln(c, "\x1b[1;31mfailed\x1b[0m <exit 1>")
+	e := &testEvent{etype: gwu.ETypeStateChange, src: c.timer}
+	c.refreshHandler(e)
+	assert.Equal(t, []gwu.Comp{c.view}, e.dirty)
+	assert.Contains(t, c.view.HTML(), `<span style="color:#CD3131;font-weight:bold;">failed</span> &lt;exit 1&gt;`)
+
+	c = g.MakeConsole(Options{Background: gwu.ClrBlack, Color: gwu.ClrWhite})
+	assert.Equal(t, gwu.ClrBlack, c.view.Style().Background())
+	assert.Equal(t, gwu.ClrWhite, c.view.Style().Color())
+}
+
+func TestAnsiToHTML(t *testing.T) {
+	tests := []struct {
+		name, line, want string
+	}{
+		{"plain", "a < b", "a &lt; b"},
+		{"color", "\x1b[31mred\x1b[39m plain", `<span style="color:#CD3131;">red</span> plain`},
+		{"unterminated", "\x1b[92mgreen", `<span style="color:#23D18B;">green</span>`},
+		{"background and reset", "\x1b[44;97mx\x1b[mY", `<span style="color:#FFFFFF;background:#2472C8;">x</span>Y`},
+		{"256 colors", "\x1b[38;5;3mx\x1b[38;5;200my", `<span style="color:#E5E510;">x</span>y`},
+		{"rgb", "\x1b[48;2;1;2;3mx", `<span style="background:rgb(1,2,3);">x</span>`},
+		{"bold off", "\x1b[1mB\x1b[22mb", `<span style="font-weight:bold;">B</span>b`},
+		{"other sequences", "\x1b[2K\x1b[1Gdone\x1b", "done"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ansiToHTML(tt.line))
+		})
+	}
+}
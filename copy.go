@@ -0,0 +1,90 @@
+package wgowut
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// MakeCopyButton creates a button made with MakeButton that places the text returned by content on the clipboard. The
+// text is produced on each click: the button fetches it synchronously from a hidden component rendering it, so the
+// browser still treats the copy as initiated by the user. The returned panel holds the button and the hidden
+// component, and must be added to the window. The button copies with an onclick attribute, so no ETypeClick handlers
+// should be added to it.
+func (g *GuiBuilder) MakeCopyButton(text string, content func() string, options Options) gwu.Panel {
+	panel := gwu.NewNaturalPanel()
+	view := &copyView{HTML: gwu.NewHTML(""), content: content}
+	btn := g.MakeButton(text, options)
+	btn.SetAttr("onclick", fmt.Sprintf("var x=new XMLHttpRequest();x.open('GET',_pathRenderComp+'?cid=%d',false);x.send();"+
+		"var d=document.createElement('div');d.innerHTML=x.responseText;navigator.clipboard.writeText(d.textContent);", int(view.ID())))
+	g.AddCompsToPanel(panel, btn, view)
+
+	return panel
+}
+
+// copyView is the hidden component of a copy button rendering the text to copy.
+type copyView struct {
+	gwu.HTML
+	content func() string
+}
+
+func (v *copyView) Render(w gwu.Writer) {
+	w.Writess(`<span id="`, v.ID().String(), `" style="display:none">`)
+	w.Writees(v.content())
+	w.Writes("</span>")
+}
+
+// EnableTableCopy adds a "Copy as TSV" button (see MakeCopyButton) placing the text of table on the clipboard as tab
+// separated values, for pasting into spreadsheets. If the table is in a panel, the button is inserted after it;
+// otherwise the returned panel holding the button has to be added to the window.
+func (g *GuiBuilder) EnableTableCopy(table gwu.Table) gwu.Panel {
+	copyBtn := g.MakeCopyButton("Copy as TSV", func() string { return tableTSV(table) }, Options{})
+
+	if parent, ok := table.Parent().(gwu.Panel); ok {
+		if idx := parent.CompIdx(table); idx >= 0 {
+			parent.Insert(copyBtn, idx+1)
+		}
+	}
+
+	return copyBtn
+}
+
+// tableTSV returns the text of the cells of table as tab separated values, a line per row.
+func tableTSV(table gwu.Table) string {
+	var lines []string
+	for row, rows := 0, tableRows(table); row < rows; row++ {
+		var cells []string
+		for col, cols := 0, tableCols(table, row); col < cols; col++ {
+			cells = append(cells, tsvField(compText(table.CompAt(row, col))))
+		}
+		lines = append(lines, strings.Join(cells, "\t"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// tsvField replaces the tabs and line breaks of text, which can't be in TSV fields, with spaces.
+func tsvField(text string) string {
+	return strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ").Replace(text)
+}
+
+// compText returns the text displayed by comp: the value of inputs (see Form.Values), the text of labels and buttons,
+// and the texts of the child components of containers separated by spaces.
+func compText(comp gwu.Comp) string {
+	switch c := comp.(type) {
+	case nil:
+		return ""
+	case gwu.CheckBox, gwu.ListBox, gwu.TextBox:
+		return fieldValue(c)
+	case gwu.HasText:
+		return c.Text()
+	}
+
+	var texts []string
+	for _, child := range childComps(comp) {
+		if text := compText(child); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, " ")
+}
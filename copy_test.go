@@ -0,0 +1,55 @@
+package wgowut
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeCopyButton(t *testing.T) {
+	g := &GuiBuilder{}
+	text := "a <b>"
+	panel := g.MakeCopyButton("Copy", func() string { return text }, Options{Color: gwu.ClrNavy})
+
+	btn, view := panel.CompAt(0).(gwu.Button), panel.CompAt(1).(*copyView)
+	assert.Equal(t, "Copy", btn.Text())
+	assert.Equal(t, gwu.ClrNavy, btn.Style().Color())
+	assert.Contains(t, btn.Attr("onclick"), fmt.Sprintf("_pathRenderComp+'?cid=%d'", view.ID()))
+
+	// the text is produced when rendered
+	text = "c\td"
+	var buf bytes.Buffer
+	view.Render(gwu.NewWriter(&buf))
+	assert.Equal(t, fmt.Sprintf(`<span id="%d" style="display:none">c`+"\t"+`d</span>`, view.ID()), buf.String())
+}
+
+func TestGuiBuilder_EnableTableCopy(t *testing.T) {
+	g := &GuiBuilder{}
+	table := g.MakeTable(Options{})
+	g.SetTableHeader(table, Options{}, "Name", "Notes", "Active")
+	cb := gwu.NewCheckBox("active")
+	cb.SetState(true)
+	inner := g.MakePanel(Options{})
+	g.AddLabelsToPanel(inner, Options{}, "two", "labels")
+	g.AddTableRow(table, g.MakeLabel("ann", Options{}), g.MakeTextBox("line1\nline2\tx", Options{}), cb)
+	g.AddTableRow(table, inner, nil, gwu.NewButton("go"))
+
+	assert.Equal(t, "Name\tNotes\tActive\nann\tline1 line2 x\ttrue\ntwo labels\t\tgo", tableTSV(table))
+
+	// the button is inserted after the table in its panel
+	panel := g.MakePanel(Options{})
+	before, after := g.MakeLabel("before", Options{}), g.MakeLabel("after", Options{})
+	g.AddCompsToPanel(panel, before, table, after)
+	copyBtn := g.EnableTableCopy(table)
+	assert.Equal(t, []gwu.Comp{before, table, copyBtn, after}, childComps(panel))
+
+	var buf bytes.Buffer
+	copyBtn.CompAt(1).Render(gwu.NewWriter(&buf))
+	assert.Contains(t, buf.String(), "ann\tline1 line2 x\ttrue")
+
+	// a table without a panel parent is left alone
+	assert.NotNil(t, g.EnableTableCopy(g.MakeTable(Options{})))
+}
@@ -0,0 +1,306 @@
+package wgowut
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// csvEndpoint is the name of the wgowut endpoint serving the CSV files of MakeCSVDownloadButton (see endpointPath).
+const csvEndpoint = "csv/"
+
+// csvDownloads holds the pending downloads of the buttons made by MakeCSVDownloadButton by their tokens, which are part
+// of their endpoint URLs, and the servers the session handler removing them was added to.
+var csvDownloads = struct {
+	sync.Mutex
+	byToken map[string]*csvDownload
+	servers map[gwu.Server]bool
+	once    sync.Once
+}{byToken: make(map[string]*csvDownload), servers: make(map[gwu.Server]bool)}
+
+// csvDownload is a CSV file exported by a click on a download button, served once to the session of the click.
+type csvDownload struct {
+	btn      gwu.ID // btn is the ID of the button the file was exported by
+	filename string
+	data     []byte
+	cookie   string // cookie is the name of the session id cookie of the server
+	sessID   string // sessID is the id of the session of the click, "" for the public session
+}
+
+// csvButton is a button made by MakeCSVDownloadButton.
+type csvButton struct {
+	gwu.Button
+	server   gwu.Server
+	dt       *DataTable
+	filename string
+	path     string // path is the path of the exported file, navigated to by the next render of the button
+}
+
+// csvSessionHandler removes the downloads of removed sessions.
+type csvSessionHandler struct{}
+
+// Created does nothing, downloads are added when buttons are clicked.
+func (csvSessionHandler) Created(sess gwu.Session) {}
+
+// Removed removes the downloads exported by the clicks of sess.
+func (csvSessionHandler) Removed(sess gwu.Session) {
+	removeCSVDownloads(func(d *csvDownload) bool { return d.sessID == sess.ID() })
+}
+
+// removeCSVDownloads removes the downloads matching the function.
+func removeCSVDownloads(match func(d *csvDownload) bool) {
+	csvDownloads.Lock()
+	defer csvDownloads.Unlock()
+
+	for token, d := range csvDownloads.byToken {
+		if match(d) {
+			delete(csvDownloads.byToken, token)
+		}
+	}
+}
+
+// ErrNotCSVTable is returned by DataTable.ReloadCSV for tables not loaded from CSV.
+var ErrNotCSVTable = errors.New("wgowut: table was not loaded from CSV")
+
+// LoadTableFromCSV creates a DataTable from delimited data. The delimiter is detected from the first line: a tab,
+// semicolon or comma, whichever occurs most (comma if none do). The first row is used as the headers if all of its
+// cells are unique, not blank and not numbers; otherwise the columns are named "Column 1", "Column 2" and so on.
+// Rows may have different numbers of cells, missing cells are blank. Cells of editable columns (see
+// DataTable.SetEditable) can be edited. Columns holding numbers sort numerically. The
+// table is made with MakeTable and uses the same options, like MakeDataTable.
+func (g *GuiBuilder) LoadTableFromCSV(r io.Reader, options Options) (*DataTable, error) {
+	columns, records, err := readCSV(r, options.Sortable)
+	if err != nil {
+		return nil, err
+	}
+	columns = configureColumns(columns, options)
+
+	dt := &DataTable{
+		g:       g,
+		options: options,
+		columns: columns,
+		all:     records,
+		records: records,
+		sortCol: -1,
+	}
+	options.Rows, options.Cols = 1, len(columns)
+	dt.Table = g.MakeTable(options)
+	dt.render()
+
+	return dt, nil
+}
+
+// ReloadCSV replaces the columns and rows of a table made with LoadTableFromCSV with newly read delimited data and
+// re-renders the table. The sort and filters are kept for columns that still exist. Mark the table dirty after calling
+// this from an event handler.
+func (dt *DataTable) ReloadCSV(r io.Reader) error {
+	if dt.elem != nil || dt.loadPage != nil {
+		return ErrNotCSVTable
+	}
+	columns, records, err := readCSV(r, dt.options.Sortable)
+	if err != nil {
+		return err
+	}
+	columns = configureColumns(columns, dt.options)
+
+	dt.columns, dt.all = columns, records
+	if dt.sortCol >= len(columns) {
+		dt.sortCol = -1
+	}
+	for col := range dt.colFilters {
+		if col >= len(columns) {
+			delete(dt.colFilters, col)
+		}
+	}
+	if len(dt.filterRow) > len(columns) {
+		dt.filterRow = dt.filterRow[:len(columns)]
+	}
+	dt.update()
+	return nil
+}
+
+// readCSV reads delimited data into columns and []string records.
+func readCSV(r io.Reader, sortable bool) ([]dataColumn, []reflect.Value, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cr := csv.NewReader(bytes.NewReader(data))
+	cr.Comma = csvDelimiter(data)
+	cr.FieldsPerRecord = -1
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("wgowut: could not read CSV: %v", err)
+	}
+
+	var headers []string
+	if len(rows) != 0 && isCSVHeader(rows[0]) {
+		headers, rows = rows[0], rows[1:]
+	}
+	for _, row := range rows {
+		for len(headers) < len(row) {
+			headers = append(headers, "Column "+strconv.Itoa(len(headers)+1))
+		}
+	}
+
+	for i, row := range rows {
+		for len(row) < len(headers) {
+			row = append(row, "")
+		}
+		rows[i] = row
+	}
+
+	columns := make([]dataColumn, len(headers))
+	for i, header := range headers {
+		columns[i] = dataColumn{name: header, header: header, index: i, sortable: sortable}
+	}
+	records := make([]reflect.Value, len(rows))
+	for i, row := range rows {
+		records[i] = reflect.ValueOf(row)
+	}
+
+	return columns, records, nil
+}
+
+// csvDelimiter returns the tab, semicolon or comma occurring most in the first line of data.
+func csvDelimiter(data []byte) rune {
+	line := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		line = data[:i]
+	}
+
+	delimiter, max := ',', bytes.Count(line, []byte{','})
+	for _, d := range []rune{'\t', ';'} {
+		if n := bytes.Count(line, []byte{byte(d)}); n > max {
+			delimiter, max = d, n
+		}
+	}
+	return delimiter
+}
+
+// isCSVHeader reports if the cells of the row are unique, not blank and not numbers.
+func isCSVHeader(row []string) bool {
+	seen := make(map[string]bool, len(row))
+	for _, cell := range row {
+		cell = strings.TrimSpace(cell)
+		if _, err := strconv.ParseFloat(cell, 64); cell == "" || err == nil || seen[cell] {
+			return false
+		}
+		seen[cell] = true
+	}
+	return true
+}
+
+// ExportCSV writes the headers and the rows passing the filters, in the displayed order and from all pages, to w as
+// CSV.
+func (dt *DataTable) ExportCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(dt.Headers()); err != nil {
+		return err
+	}
+
+	for _, record := range dt.Values() {
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// MakeCSVDownloadButton creates a "Download CSV" button made with MakeButton that downloads the contents of the table
+// (see ExportCSV) as filename. A click exports the table in the event handler, so the export doesn't race with the
+// other handlers of the session, and the button then navigates to the file, which is served once, to the session of
+// the click, by an endpoint under the app path of server at a random path. Files that weren't downloaded are removed
+// by the next click in the session, with the session, or when the button is discarded with ForgetTree.
+func (g *GuiBuilder) MakeCSVDownloadButton(server gwu.Server, dt *DataTable, filename string, options Options) gwu.Button {
+	csvDownloads.once.Do(func() { endpoints.mux.HandleFunc("/"+csvEndpoint, serveCSV) })
+	csvDownloads.Lock()
+	if !csvDownloads.servers[server] {
+		csvDownloads.servers[server] = true
+		server.AddSHandler(csvSessionHandler{})
+	}
+	csvDownloads.Unlock()
+
+	btn := &csvButton{Button: g.MakeButton("Download CSV", options), server: server, dt: dt, filename: filename}
+	btn.AddEHandlerFunc(btn.handleClick, gwu.ETypeClick)
+
+	return btn
+}
+
+// handleClick exports the table and re-renders the button to navigate to the exported file.
+func (b *csvButton) handleClick(e gwu.Event) {
+	var buf bytes.Buffer
+	if err := b.dt.ExportCSV(&buf); err != nil {
+		log.Printf("wgowut: could not export CSV: %v", err)
+		return
+	}
+
+	sessID := e.Session().ID()
+	removeCSVDownloads(func(d *csvDownload) bool { return d.btn == b.ID() && d.sessID == sessID })
+
+	token := newToken()
+	csvDownloads.Lock()
+	csvDownloads.byToken[token] = &csvDownload{btn: b.ID(), filename: b.filename, data: buf.Bytes(),
+		cookie: b.server.SessIDCookieName(), sessID: sessID}
+	csvDownloads.Unlock()
+
+	b.path = endpointPath(b.server.AppPath(), csvEndpoint) + token
+	e.MarkDirty(b)
+}
+
+// Render renders the button, with a script navigating to the exported file inside it after a click.
+func (b *csvButton) Render(w gwu.Writer) {
+	if b.path == "" {
+		b.Button.Render(w)
+		return
+	}
+
+	var buf bytes.Buffer
+	b.Button.Render(gwu.NewWriter(&buf))
+	script := fmt.Sprintf("<script>window.location.href='%s';</script></button>", b.path)
+	w.Write(bytes.Replace(buf.Bytes(), []byte("</button>"), []byte(script), 1))
+	b.path = ""
+}
+
+// forget removes the downloads of the button that weren't downloaded.
+func (b *csvButton) forget() {
+	removeCSVDownloads(func(d *csvDownload) bool { return d.btn == b.ID() })
+}
+
+// serveCSV serves an exported CSV file once, to the session of the click that exported it.
+func serveCSV(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.URL.Path, "/"+csvEndpoint)
+	csvDownloads.Lock()
+	d := csvDownloads.byToken[token]
+	if d != nil && d.sessID != "" {
+		if c, err := r.Cookie(d.cookie); err != nil || c.Value != d.sessID {
+			d = nil
+		}
+	}
+	if d != nil {
+		delete(csvDownloads.byToken, token)
+	}
+	csvDownloads.Unlock()
+	if d == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", d.filename))
+	w.Write(d.data)
+}
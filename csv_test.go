@@ -0,0 +1,199 @@
+package wgowut
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataTable_ExportCSV(t *testing.T) {
+	data := []testServer{
+		{Name: "alpha", Port: 80, Tags: []string{"web", "prod"}},
+		{Name: `"quoted", name`, Port: 443},
+		{Name: "beta", Port: 8080},
+	}
+
+	tests := []struct {
+		name    string
+		options Options
+		prepare func(dt *DataTable)
+		want    string
+	}{
+		{"all rows", Options{}, func(dt *DataTable) {},
+			"Server name,Port,Load,Online,Tags\n" +
+				"alpha,80,0,false,[web prod]\n" +
+				"\"\"\"quoted\"\", name\",443,0,false,[]\n" +
+				"beta,8080,0,false,[]\n"},
+		{"sorted and filtered from all pages", Options{PageSize: 1}, func(dt *DataTable) {
+			dt.SetFilter("a")
+			dt.Sort(1, true)
+		},
+			"Server name,Port,Load,Online,Tags\n" +
+				"beta,8080,0,false,[]\n" +
+				"\"\"\"quoted\"\", name\",443,0,false,[]\n" +
+				"alpha,80,0,false,[web prod]\n"},
+		{"no rows", Options{}, func(dt *DataTable) { dt.SetFilter("delta") },
+			"Server name,Port,Load,Online,Tags\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			dt, err := g.MakeDataTable(data, tt.options)
+			assert.NoError(t, err)
+			tt.prepare(dt)
+
+			var buf bytes.Buffer
+			assert.NoError(t, dt.ExportCSV(&buf))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestGuiBuilder_MakeCSVDownloadButton(t *testing.T) {
+	g := &GuiBuilder{}
+	dt, err := g.MakeDataTable([]testServer{{Name: "alpha"}}, Options{})
+	assert.NoError(t, err)
+	server := gwu.NewServer("csvtest", "")
+
+	download := func(path, sessID string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		if sessID != "" {
+			r.AddCookie(&http.Cookie{Name: server.SessIDCookieName(), Value: sessID})
+		}
+		http.DefaultServeMux.ServeHTTP(w, r)
+		return w
+	}
+	// click clicks btn in the session and returns the path of the exported file the button navigates to
+	click := func(btn gwu.Button, sess gwu.Session) string {
+		e := &testEvent{etype: gwu.ETypeClick, src: btn, sess: sess}
+		btn.(*csvButton).handleClick(e)
+		assert.Equal(t, []gwu.Comp{btn}, e.dirty)
+		html := renderHTML(btn)
+		i := strings.Index(html, "<script>window.location.href='/csvtest/_wgowut/csv/")
+		if !assert.True(t, i >= 0) || !assert.True(t, strings.HasSuffix(html, "';</script></button>")) {
+			return ""
+		}
+		assert.NotContains(t, renderHTML(btn), "<script>") // the navigation is rendered once
+		return strings.TrimSuffix(html[i+len("<script>window.location.href='"):], "';</script></button>")
+	}
+
+	btn := g.MakeCSVDownloadButton(server, dt, "servers.csv", Options{})
+	assert.Equal(t, "Download CSV", btn.Text())
+	assert.NotContains(t, renderHTML(btn), "<script>")
+
+	// the contents at the time of the click are served once, each click gets its own unguessable path
+	public := newTestSession("")
+	path := click(btn, public)
+	assert.NoError(t, dt.SetData([]testServer{{Name: "beta"}}))
+	assert.NotEqual(t, path, click(btn, public))
+	assert.Equal(t, http.StatusNotFound, download(path, "").Code) // replaced by the second click
+	path = click(btn, public)
+	w := download(path, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="servers.csv"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "Server name,Port,Load,Online,Tags\nbeta,0,0,false,[]\n", w.Body.String())
+	assert.Equal(t, http.StatusNotFound, download(path, "").Code)
+
+	// files exported in private sessions are only served to them
+	s1 := newTestSession("s1")
+	path = click(btn, s1)
+	assert.Equal(t, http.StatusNotFound, download(path, "").Code)
+	assert.Equal(t, http.StatusNotFound, download(path, "s2").Code)
+	assert.Equal(t, http.StatusOK, download(path, "s1").Code)
+
+	// files not downloaded are removed with their session or button
+	path = click(btn, s1)
+	csvSessionHandler{}.Removed(s1)
+	assert.Equal(t, http.StatusNotFound, download(path, "s1").Code)
+	path = click(btn, public)
+	g.ForgetTree(btn)
+	assert.Equal(t, http.StatusNotFound, download(path, "").Code)
+}
+
+func TestGuiBuilder_LoadTableFromCSV(t *testing.T) {
+	tests := []struct {
+		name        string
+		csv         string
+		wantErr     bool
+		wantHeaders []string
+		wantCells   [][]string
+	}{
+		{"comma with header", "name,port\nalpha,80\nbeta,8080\n", false,
+			[]string{"name", "port"}, [][]string{{"alpha", "80"}, {"beta", "8080"}}},
+		{"tab with header", "name\tdesc\nalpha\ta, b\n", false,
+			[]string{"name", "desc"}, [][]string{{"alpha", "a, b"}}},
+		{"semicolon", "name;port\nalpha;80\n", false,
+			[]string{"name", "port"}, [][]string{{"alpha", "80"}}},
+		{"numeric first row", "1,2\n3,4\n", false,
+			[]string{"Column 1", "Column 2"}, [][]string{{"1", "2"}, {"3", "4"}}},
+		{"duplicate first row cells", "a,a\nb,c\n", false,
+			[]string{"Column 1", "Column 2"}, [][]string{{"a", "a"}, {"b", "c"}}},
+		{"ragged rows", "name,port\nalpha\nbeta,80,extra\n", false,
+			[]string{"name", "port", "Column 3"}, [][]string{{"alpha", "", ""}, {"beta", "80", "extra"}}},
+		{"quoted", "name,desc\n\"alpha\",\"x \"\"y\"\"\"\n", false,
+			[]string{"name", "desc"}, [][]string{{"alpha", `x "y"`}}},
+		{"empty", "", false, nil, nil},
+		{"bad quotes", "name\n\"alpha\n", true, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			dt, err := g.LoadTableFromCSV(strings.NewReader(tt.csv), Options{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, len(tt.wantHeaders), len(dt.Headers()))
+			for col, header := range tt.wantHeaders {
+				assert.Equal(t, header, dt.Headers()[col])
+				assert.Equal(t, header, dt.CompAt(0, col).(gwu.Label).Text())
+			}
+			assert.Equal(t, len(tt.wantCells), dt.Len())
+			for row, cells := range tt.wantCells {
+				for col, text := range cells {
+					assert.Equal(t, text, dt.Cell(row, col))
+					assert.Equal(t, text, dt.CompAt(row+1, col).(gwu.Label).Text())
+				}
+			}
+		})
+	}
+}
+
+func TestDataTable_ReloadCSV(t *testing.T) {
+	g := &GuiBuilder{}
+	dt, err := g.LoadTableFromCSV(strings.NewReader("name,port,extra\nalpha,80,x\nbeta,9,y\ngamma,100,z\n"), Options{Sortable: true})
+	assert.NoError(t, err)
+
+	// numbers sort numerically
+	dt.Sort(1, false)
+	assert.Equal(t, []string{"beta", "alpha", "gamma"}, []string{dt.Cell(0, 0), dt.Cell(1, 0), dt.Cell(2, 0)})
+	dt.SetColumnFilter(2, "y")
+	assert.Equal(t, 1, dt.Len())
+
+	// the sort is kept, the filter of the removed column is not
+	assert.NoError(t, dt.ReloadCSV(strings.NewReader("name,port\ndelta,1000\nepsilon,20\n")))
+	assert.Equal(t, []string{"name", "port"}, dt.Headers())
+	assert.Equal(t, 2, dt.Len())
+	assert.Equal(t, "epsilon", dt.Cell(0, 0))
+	assert.Equal(t, 1, dt.CompAt(0, 0).HandlersCount(gwu.ETypeClick))
+	assert.Equal(t, "port"+SortAscIndicator, dt.CompAt(0, 1).(gwu.Label).Text())
+
+	assert.NoError(t, dt.ReloadCSV(strings.NewReader("name\nzeta\n")))
+	col, _ := dt.SortColumn()
+	assert.Equal(t, -1, col)
+
+	assert.Error(t, dt.ReloadCSV(strings.NewReader("name\n\"zeta\n")))
+
+	structTable, err := g.MakeDataTable([]testServer{}, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, ErrNotCSVTable, structTable.ReloadCSV(strings.NewReader("name\n")))
+}
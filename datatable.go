@@ -0,0 +1,688 @@
+package wgowut
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// ErrNotStructSlice is returned by MakeDataTable and DataTable.SetData when the data is not a slice of structs or of
+// pointers to structs.
+var ErrNotStructSlice = errors.New("wgowut: data must be a slice of structs or of pointers to structs")
+
+// DataTable is a gwu.Table created by GuiBuilder.MakeDataTable (or LoadTableFromCSV) that displays a slice of structs
+// (or CSV records), one row per element under a header row. It remembers the column layout and the data so it can be
+// re-rendered.
+type DataTable struct {
+	gwu.Table
+	g       *GuiBuilder
+	options Options
+	elem    reflect.Type // elem is nil for tables loaded from CSV
+	columns []dataColumn
+	all     []reflect.Value
+	records []reflect.Value // records are the filtered and sorted records
+
+	filter     string
+	colFilters map[int]string
+	filterRow  []gwu.Comp
+
+	sortCol  int
+	sortDesc bool
+
+	page                                  int
+	pagerButtonOptions, pagerLabelOptions Options
+
+	onCellChanged func(row, col int, old, new string)
+	cellRules     []func(row, col int, value string) *Options
+	heatmaps      map[int]Palette
+
+	loadPage LoadPageFunc // loadPage is set for tables made with MakePagedDataTable
+	total    int
+	loadErr  error
+}
+
+// dataColumn is a struct field displayed as a DataTable column.
+type dataColumn struct {
+	name      string
+	header    string
+	index     int
+	sortable  bool
+	editable  bool
+	values    []string // values are the choices of an editable column rendered as a list box
+	formatter string   // formatter is the name of the formatter of the column, see RegisterFormatter
+
+	width      string
+	halign     gwu.HAlign
+	filterable bool
+}
+
+// Sort indicators appended to the header of the sorted column.
+const (
+	SortAscIndicator  = " \u25b2"
+	SortDescIndicator = " \u25bc"
+)
+
+// MakeDataTable creates a DataTable from data, which must be a slice of structs or of pointers to structs. Every
+// exported struct field is a column; the header is the field name, or the label from the struct tag (see BuildForm,
+// other tag keys are ignored). A tag of "-" skips the field. Strings, bools and numbers are formatted like in
+// BuildForm, other values with fmt, unless the "formatter" tag key names a formatter (see RegisterFormatter) which is
+// then used for the column, except when it's editable. The table is made with MakeTable and uses the same options,
+// Rows and Cols are set from the data. The Columns option configures the columns by position, see ColumnConfig.
+//
+// If the Sortable option is set, clicking a column header sorts the rows by that column, ascending first and then
+// toggling. Strings, bools, numbers and time.Time values are compared by value, other values by their text. A column
+// is left unsortable with the "nosort" tag key.
+//
+// Columns with the "editable" tag key are rendered as text boxes, or as list boxes if the "values" tag key is also
+// given (see BuildForm), and edits are written back to the data; see DataTable.SetEditable and OnCellChanged.
+//
+// If the PageSize option is set, only a page of rows is rendered at a time and a last row is added with first,
+// previous, next and last buttons and a page indicator label, which can be styled with DataTable.StylePager.
+func (g *GuiBuilder) MakeDataTable(data interface{}, options Options) (*DataTable, error) {
+	elem, records, err := dataRecords(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &DataTable{
+		g:       g,
+		options: options,
+		elem:    elem,
+		columns: configureColumns(dataColumns(elem, options.Sortable), options),
+		all:     records,
+		records: records,
+		sortCol: -1,
+	}
+	options.Rows, options.Cols = 1, len(dt.columns)
+	dt.Table = g.MakeTable(options)
+	dt.render()
+
+	return dt, nil
+}
+
+// dataRecords returns the element struct type and the struct values of the slice data.
+func dataRecords(data interface{}) (reflect.Type, []reflect.Value, error) {
+	rv := reflect.ValueOf(data)
+	if rv.Kind() != reflect.Slice {
+		return nil, nil, ErrNotStructSlice
+	}
+	elem := rv.Type().Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return nil, nil, ErrNotStructSlice
+	}
+
+	records := make([]reflect.Value, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		record := rv.Index(i)
+		if record.Kind() == reflect.Ptr {
+			if record.IsNil() {
+				record = reflect.Zero(elem)
+			} else {
+				record = record.Elem()
+			}
+		}
+		records = append(records, record)
+	}
+	return elem, records, nil
+}
+
+// dataColumns returns the columns of the exported fields of the struct type.
+func dataColumns(elem reflect.Type, sortable bool) []dataColumn {
+	var columns []dataColumn
+	for i := 0; i < elem.NumField(); i++ {
+		sf := elem.Field(i)
+		tag := sf.Tag.Get(TagName)
+		if sf.PkgPath != "" || tag == "-" {
+			continue
+		}
+
+		column := dataColumn{name: sf.Name, header: sf.Name, index: i, sortable: sortable}
+		for _, part := range strings.Split(tag, ",") {
+			kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+			switch {
+			case kv[0] == "label" && len(kv) == 2:
+				column.header = kv[1]
+			case kv[0] == "nosort":
+				column.sortable = false
+			case kv[0] == "editable":
+				column.editable = true
+			case kv[0] == "values" && len(kv) == 2:
+				column.values = strings.Split(kv[1], "|")
+			case kv[0] == "formatter" && len(kv) == 2:
+				column.formatter = kv[1]
+			}
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// render clears the table and fills it with the header row, the filter row if there is one, and the records.
+func (dt *DataTable) render() {
+	for _, child := range childComps(dt.Table) {
+		if !dt.inFilterRow(child) {
+			dt.g.ForgetTree(child)
+		}
+	}
+	dt.Clear()
+	start, end := dt.pageRange()
+	first := dt.firstDataRow()
+	dt.EnsureSize(end-start+first, len(dt.columns))
+
+	for col, column := range dt.columns {
+		text := column.header
+		if col == dt.sortCol {
+			if dt.sortDesc {
+				text += SortDescIndicator
+			} else {
+				text += SortAscIndicator
+			}
+		}
+		header := dt.g.MakeLabel(text, Options{})
+		header.Style().SetFontWeight(gwu.FontWeightBold)
+		if column.sortable {
+			header.Style().SetCursor(gwu.CursorPointer)
+			header.AddEHandlerFunc(dt.sortHandler(col), gwu.ETypeClick)
+		}
+		dt.Add(header, 0, col)
+		if column.width != "" || column.halign != "" {
+			dt.g.applyCellFmt(dt.Table, 0, col, Options{CellPadding: dt.options.CellPadding, Width: column.width, HAlign: column.halign})
+		}
+	}
+
+	for col, comp := range dt.filterRow {
+		if comp != nil {
+			dt.Add(comp, 1, col)
+		}
+	}
+
+	ranges := dt.heatmapRanges()
+	for row := start; row < end; row++ {
+		for col, column := range dt.columns {
+			text := dt.Cell(row, col)
+			dt.formatDataCell(ranges, row, col, row-start+first, text)
+			if !column.editable {
+				dt.Add(dt.g.MakeLabel(text, Options{}), row-start+first, col)
+				continue
+			}
+
+			var input gwu.Comp
+			if column.values != nil {
+				lb := dt.g.MakeListBox(column.values, Options{Rows: 1})
+				selectValue(lb, text)
+				input = lb
+			} else {
+				input = dt.g.MakeTextBox(text, Options{})
+			}
+			input.AddEHandlerFunc(dt.cellHandler(row, col, input), gwu.ETypeChange)
+			dt.Add(input, row-start+first, col)
+		}
+	}
+
+	setColWidths(dt.Table, end-start+first, dt.options.ColWidths)
+	if color := dt.g.tableStripe(dt.Table); color != "" {
+		// starting a row early stripes the first data row
+		stripeRows(dt.Table, first-1, end-start+first, color)
+	}
+
+	if dt.loadErr != nil {
+		dt.renderLoadError(end - start + first)
+	}
+	if dt.options.PageSize > 0 {
+		dt.renderPager(tableRows(dt.Table))
+	}
+}
+
+// firstDataRow returns the table row of the first displayed record.
+func (dt *DataTable) firstDataRow() int {
+	if len(dt.filterRow) != 0 {
+		return 2
+	}
+	return 1
+}
+
+func (dt *DataTable) inFilterRow(comp gwu.Comp) bool {
+	for _, c := range dt.filterRow {
+		if c == comp {
+			return true
+		}
+	}
+	return false
+}
+
+// pageRange returns the range of the records on the current page.
+func (dt *DataTable) pageRange() (start, end int) {
+	if dt.options.PageSize <= 0 || dt.loadPage != nil {
+		return 0, len(dt.records)
+	}
+	start = dt.page * dt.options.PageSize
+	end = start + dt.options.PageSize
+	if end > len(dt.records) {
+		end = len(dt.records)
+	}
+	return start, end
+}
+
+// renderPager adds the pagination controls spanning all columns of the row.
+func (dt *DataTable) renderPager(row int) {
+	last := dt.PageCount() - 1
+	pagerButton := func(text string, page int, enabled bool) gwu.Button {
+		btn := dt.g.MakeButton(text, dt.pagerButtonOptions)
+		btn.SetEnabled(enabled)
+		btn.AddEHandlerFunc(dt.pageHandler(page), gwu.ETypeClick)
+		return btn
+	}
+
+	pager := dt.g.MakePanel(Options{Layout: LayoutHorizontal, CellPadding: 2})
+	dt.g.AddCompsToPanel(pager,
+		pagerButton("<<", 0, dt.page > 0),
+		pagerButton("<", dt.page-1, dt.page > 0),
+		dt.g.MakeLabel(fmt.Sprintf("Page %d of %d", dt.page+1, last+1), dt.pagerLabelOptions),
+		pagerButton(">", dt.page+1, dt.page < last),
+		pagerButton(">>", last, dt.page < last),
+	)
+
+	dt.EnsureSize(row+1, len(dt.columns))
+	dt.Add(pager, row, 0)
+	dt.SetColSpan(row, 0, len(dt.columns))
+	dt.CellFmt(row, 0).SetHAlign(gwu.HACenter)
+}
+
+func (dt *DataTable) pageHandler(page int) func(e gwu.Event) {
+	return func(e gwu.Event) {
+		dt.SetPage(page)
+		e.MarkDirty(dt)
+	}
+}
+
+// Page returns the index of the displayed page, starting at 0.
+func (dt *DataTable) Page() int {
+	return dt.page
+}
+
+// PageCount returns the number of pages, which is 1 if the PageSize option is not set or there are no rows. For
+// tables made with MakePagedDataTable, it's computed from the total returned by the LoadPageFunc.
+func (dt *DataTable) PageCount() int {
+	rows := len(dt.records)
+	if dt.loadPage != nil {
+		rows = dt.total
+	}
+	if dt.options.PageSize <= 0 || rows == 0 {
+		return 1
+	}
+	return (rows + dt.options.PageSize - 1) / dt.options.PageSize
+}
+
+// SetPage displays the page with the given index, limited to the existing pages, and re-renders the table. For tables
+// made with MakePagedDataTable, the current page is kept if the page can't be loaded. Mark the table dirty after
+// calling this from an event handler.
+func (dt *DataTable) SetPage(page int) {
+	prev := dt.page
+	dt.page = page
+	dt.clampPage()
+	if dt.loadPage != nil {
+		dt.load()
+		if dt.loadErr != nil {
+			dt.page = prev
+			dt.update()
+		}
+		return
+	}
+	dt.render()
+}
+
+func (dt *DataTable) clampPage() {
+	if last := dt.PageCount() - 1; dt.page > last {
+		dt.page = last
+	}
+	if dt.page < 0 {
+		dt.page = 0
+	}
+}
+
+// StylePager sets the options used to make the pagination buttons and the page indicator label, and re-renders the
+// table.
+func (dt *DataTable) StylePager(buttonOptions, labelOptions Options) {
+	dt.pagerButtonOptions, dt.pagerLabelOptions = buttonOptions, labelOptions
+	dt.render()
+}
+
+// SetData replaces the displayed data and re-renders the table. The data must have the same element type as the data
+// the table was made with. Mark the table dirty after calling this from an event handler.
+func (dt *DataTable) SetData(data interface{}) error {
+	elem, records, err := dataRecords(data)
+	if err != nil {
+		return err
+	}
+	if elem != dt.elem {
+		return fmt.Errorf("wgowut: data element type %v doesn't match the table's %v", elem, dt.elem)
+	}
+
+	dt.all = records
+	dt.update()
+	return nil
+}
+
+// update filters and sorts the records, then re-renders the table.
+func (dt *DataTable) update() {
+	dt.records = dt.records[:0:0]
+	for _, record := range dt.all {
+		if dt.matches(record) {
+			dt.records = append(dt.records, record)
+		}
+	}
+	if dt.sortCol >= 0 && dt.loadPage == nil {
+		dt.sortRecords()
+	}
+	dt.clampPage()
+	dt.render()
+}
+
+// matches reports if the record passes the filters.
+func (dt *DataTable) matches(record reflect.Value) bool {
+	contains := func(col int, filter string) bool {
+		text := dt.cellText(record, col)
+		return strings.Contains(strings.ToLower(text), filter)
+	}
+
+	for col, filter := range dt.colFilters {
+		if !contains(col, filter) {
+			return false
+		}
+	}
+	if dt.filter == "" {
+		return true
+	}
+	for col := range dt.columns {
+		if contains(col, dt.filter) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeFilter(text string) string {
+	return strings.ToLower(strings.TrimSpace(text))
+}
+
+// SetFilter displays only the records with a column containing text, ignoring case, and re-renders the table. A blank
+// text removes the filter. Mark the table dirty after calling this from an event handler.
+func (dt *DataTable) SetFilter(text string) {
+	dt.filter = normalizeFilter(text)
+	dt.update()
+}
+
+// SetColumnFilter displays only the records with the column containing text, ignoring case, and re-renders the
+// table. Column filters are combined with each other and with SetFilter. A blank text removes the column filter. Mark
+// the table dirty after calling this from an event handler.
+func (dt *DataTable) SetColumnFilter(col int, text string) {
+	text = normalizeFilter(text)
+	if text == "" {
+		delete(dt.colFilters, col)
+	} else {
+		if dt.colFilters == nil {
+			dt.colFilters = make(map[int]string)
+		}
+		dt.colFilters[col] = text
+	}
+	dt.update()
+}
+
+func (dt *DataTable) sortHandler(col int) func(e gwu.Event) {
+	return func(e gwu.Event) {
+		dt.Sort(col, col == dt.sortCol && !dt.sortDesc)
+		e.MarkDirty(dt)
+	}
+}
+
+// Sort sorts the rows by the column, in descending order if desc is true, and re-renders the table. The sort is
+// stable and is kept when the data is replaced with SetData. Tables made with MakePagedDataTable load the page again
+// with the new SortSpec instead. Mark the table dirty after calling this from an event handler.
+func (dt *DataTable) Sort(col int, desc bool) {
+	dt.sortCol, dt.sortDesc = col, desc
+	if dt.loadPage != nil {
+		dt.load()
+		return
+	}
+	dt.sortRecords()
+	dt.render()
+}
+
+// SortColumn returns the column the rows are sorted by, -1 if they are not sorted, and if the order is descending.
+func (dt *DataTable) SortColumn() (col int, desc bool) {
+	return dt.sortCol, dt.sortDesc
+}
+
+func (dt *DataTable) sortRecords() {
+	less := lessValue
+	if dt.elem == nil {
+		less = lessText
+	}
+	sort.SliceStable(dt.records, func(i, j int) bool {
+		a, b := dt.field(dt.records[i], dt.sortCol), dt.field(dt.records[j], dt.sortCol)
+		if dt.sortDesc {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+}
+
+// lessValue reports if a sorts before b, comparing strings, bools, numbers and times by value and anything else by
+// its formatted text.
+func lessValue(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	}
+	if ta, ok := a.Interface().(time.Time); ok {
+		return ta.Before(b.Interface().(time.Time))
+	}
+	return formatCell(a) < formatCell(b)
+}
+
+// lessText reports if the text a sorts before b, comparing numerically if both are numbers.
+func lessText(a, b reflect.Value) bool {
+	fa, errA := strconv.ParseFloat(strings.TrimSpace(a.String()), 64)
+	fb, errB := strconv.ParseFloat(strings.TrimSpace(b.String()), 64)
+	if errA == nil && errB == nil {
+		return fa < fb
+	}
+	return a.String() < b.String()
+}
+
+// Columns returns the struct field names of the columns in order.
+func (dt *DataTable) Columns() []string {
+	names := make([]string, len(dt.columns))
+	for i, column := range dt.columns {
+		names[i] = column.name
+	}
+	return names
+}
+
+// Headers returns the header texts of the columns in order.
+func (dt *DataTable) Headers() []string {
+	headers := make([]string, len(dt.columns))
+	for i, column := range dt.columns {
+		headers[i] = column.header
+	}
+	return headers
+}
+
+// Len returns the number of data rows passing the filters on all pages, not counting the header row.
+func (dt *DataTable) Len() int {
+	return len(dt.records)
+}
+
+// Cell returns the formatted text of the data row and column, with 0 being the first data row (passing the filters)
+// of the first page.
+func (dt *DataTable) Cell(row, col int) string {
+	return dt.cellText(dt.records[row], col)
+}
+
+// cellText formats the value of the column in the record with the formatter of the column, if it has one and isn't
+// editable, or with formatCell.
+func (dt *DataTable) cellText(record reflect.Value, col int) string {
+	fv := dt.field(record, col)
+	if column := dt.columns[col]; column.formatter != "" && !column.editable {
+		return dt.g.Format(column.formatter, fv.Interface())
+	}
+	return formatCell(fv)
+}
+
+// field returns the value of the column in the record, which is a struct field, or a []string element for tables
+// loaded from CSV.
+func (dt *DataTable) field(record reflect.Value, col int) reflect.Value {
+	index := dt.columns[col].index
+	if dt.elem != nil {
+		return record.Field(index)
+	}
+	if index >= record.Len() {
+		return reflect.ValueOf("")
+	}
+	return record.Index(index)
+}
+
+// formatCell formats a struct field value for display.
+func formatCell(fv reflect.Value) string {
+	if isScalar(fv) {
+		return formatValue(fv)
+	}
+	return fmt.Sprint(fv.Interface())
+}
+
+// isScalar reports if the value is a string, bool or number, which formatValue and parseValue support.
+func isScalar(fv reflect.Value) bool {
+	switch fv.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// SetEditable makes the column editable, rendered as text boxes, or as list boxes of values if values is not nil, and
+// re-renders the table. Edits are written back to the data, only string, bool and number fields (and CSV cells) can be
+// edited. Mark the table dirty after calling this from an event handler.
+func (dt *DataTable) SetEditable(col int, values []string) {
+	dt.columns[col].editable = true
+	dt.columns[col].values = values
+	dt.render()
+}
+
+// OnCellChanged sets the function called after the user edits a cell of an editable column, with the data row and
+// column (as in Cell) and the old and new formatted values. Edits that can't be parsed into the field are reverted
+// without calling fn.
+func (dt *DataTable) OnCellChanged(fn func(row, col int, old, new string)) {
+	dt.onCellChanged = fn
+}
+
+func (dt *DataTable) cellHandler(row, col int, input gwu.Comp) func(e gwu.Event) {
+	return func(e gwu.Event) {
+		old := dt.Cell(row, col)
+		text := fieldValue(input)
+		if text == old {
+			return
+		}
+
+		fv := dt.field(dt.records[row], col)
+		if !fv.CanSet() || !isScalar(fv) || parseValue(fv, text) != nil {
+			if lb, ok := input.(gwu.ListBox); ok {
+				selectValue(lb, old)
+			} else {
+				input.(gwu.TextBox).SetText(old)
+			}
+			e.MarkDirty(input)
+			return
+		}
+
+		if len(dt.cellRules) != 0 {
+			dt.render()
+			e.MarkDirty(dt)
+		}
+		if dt.onCellChanged != nil {
+			dt.onCellChanged(row, col, old, dt.Cell(row, col))
+		}
+	}
+}
+
+// AddCellRule adds a conditional formatting rule to the DataTable and re-renders it. Whenever the table is rendered
+// (and after the user edits a cell), rule is called for every displayed data cell with its data row and column (as in
+// Cell) and formatted value; the cell is formatted like with FormatTableCell if it returns options, for example to turn
+// a cell red when a threshold is exceeded. Rules are applied in the order they were added, the options set by a rule
+// override those of earlier rules, the heatmap and the column alignment. Mark the table dirty after calling this from
+// an event handler.
+func (g *GuiBuilder) AddCellRule(dt *DataTable, rule func(row, col int, value string) *Options) {
+	dt.cellRules = append(dt.cellRules, rule)
+	dt.render()
+}
+
+// formatDataCell formats the table cell at tableRow displaying the data row and column with the alignment of the
+// column, the heatmap color and the options of the cell rules, in that order.
+func (dt *DataTable) formatDataCell(ranges map[int]heatmapRange, row, col, tableRow int, value string) {
+	options := Options{CellPadding: dt.options.CellPadding, HAlign: dt.columns[col].halign}
+	formatted := options.HAlign != ""
+	if color := dt.heatmapColor(ranges, row, col); color != "" {
+		options.Background = color
+		formatted = true
+	}
+	for _, rule := range dt.cellRules {
+		if ruleOptions := rule(row, col, value); ruleOptions != nil {
+			mergeOptions(&options, *ruleOptions)
+			formatted = true
+		}
+	}
+	if formatted {
+		dt.g.applyCellFmt(dt.Table, tableRow, col, options)
+	}
+}
+
+// mergeOptions sets the options of dst that are set (not zero) in src.
+func mergeOptions(dst *Options, src Options) {
+	dv, sv := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src)
+	for i := 0; i < sv.NumField(); i++ {
+		if !sv.Field(i).IsZero() {
+			dv.Field(i).Set(sv.Field(i))
+		}
+	}
+}
+
+// Values returns a snapshot of the formatted values of the rows passing the filters, in the displayed order and from
+// all pages, including the edits made by the user.
+func (dt *DataTable) Values() [][]string {
+	values := make([][]string, len(dt.records))
+	for row := range dt.records {
+		values[row] = make([]string, len(dt.columns))
+		for col := range dt.columns {
+			values[row][col] = dt.Cell(row, col)
+		}
+	}
+	return values
+}
+
+// selectValue selects only the given value in the list box, or nothing if it's not one of its values.
+func selectValue(lb gwu.ListBox, value string) {
+	lb.ClearSelected()
+	for i, v := range lb.Values() {
+		if v == value {
+			lb.SetSelected(i, true)
+			return
+		}
+	}
+}
@@ -0,0 +1,414 @@
+package wgowut
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+type testServer struct {
+	Name    string `wgowut:"label=Server name,required"`
+	Port    int
+	Load    float64
+	Online  bool
+	Tags    []string
+	Skipped string `wgowut:"-"`
+	hidden  string
+}
+
+func TestGuiBuilder_MakeDataTable(t *testing.T) {
+
+	servers := []testServer{
+		{Name: "alpha", Port: 80, Load: 0.5, Online: true, Tags: []string{"web"}},
+		{Name: "beta", Port: 8080, Load: 1.25},
+	}
+
+	tests := []struct {
+		name      string
+		data      interface{}
+		wantErr   bool
+		wantCells [][]string
+	}{
+		{"struct slice", servers, false, [][]string{
+			{"Server name", "Port", "Load", "Online", "Tags"},
+			{"alpha", "80", "0.5", "true", "[web]"},
+			{"beta", "8080", "1.25", "false", "[]"},
+		}},
+		{"pointer slice", []*testServer{&servers[1], nil}, false, [][]string{
+			{"Server name", "Port", "Load", "Online", "Tags"},
+			{"beta", "8080", "1.25", "false", "[]"},
+			{"", "0", "0", "false", "[]"},
+		}},
+		{"empty slice", []testServer{}, false, [][]string{
+			{"Server name", "Port", "Load", "Online", "Tags"},
+		}},
+		{"not a slice", servers[0], true, nil},
+		{"not a struct slice", []string{"a"}, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			dt, err := g.MakeDataTable(tt.data, Options{CellPadding: 2})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, []string{"Name", "Port", "Load", "Online", "Tags"}, dt.Columns())
+			assert.Equal(t, tt.wantCells[0], dt.Headers())
+			assert.Equal(t, len(tt.wantCells)-1, dt.Len())
+			assert.Equal(t, len(tt.wantCells), tableRows(dt.Table))
+
+			for row, cells := range tt.wantCells {
+				assert.Equal(t, len(cells), tableCols(dt.Table, row))
+				for col, text := range cells {
+					assert.Equal(t, text, dt.CompAt(row, col).(gwu.Label).Text())
+					if row > 0 {
+						assert.Equal(t, text, dt.Cell(row-1, col))
+					}
+				}
+			}
+			assert.Equal(t, gwu.FontWeightBold, dt.CompAt(0, 0).Style().Get("font-weight"))
+		})
+	}
+}
+
+func TestDataTable_SetData(t *testing.T) {
+	g := &GuiBuilder{}
+	dt, err := g.MakeDataTable([]testServer{{Name: "alpha"}}, Options{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, dt.SetData([]*testServer{{Name: "beta"}, {Name: "gamma"}}))
+	assert.Equal(t, 2, dt.Len())
+	assert.Equal(t, 3, tableRows(dt.Table))
+	assert.Equal(t, "Server name", dt.CompAt(0, 0).(gwu.Label).Text())
+	assert.Equal(t, "gamma", dt.CompAt(2, 0).(gwu.Label).Text())
+
+	assert.Error(t, dt.SetData([]struct{ Name string }{{"delta"}}))
+	assert.Error(t, dt.SetData("delta"))
+	assert.Equal(t, 2, dt.Len())
+}
+
+type testSortable struct {
+	Name    string
+	Port    int
+	Up      bool
+	Started time.Time
+	Tags    []string `wgowut:"nosort"`
+}
+
+func TestDataTable_Sort(t *testing.T) {
+	now := time.Now()
+	data := []testSortable{
+		{Name: "beta", Port: 443, Up: true, Started: now.Add(time.Hour), Tags: []string{"b"}},
+		{Name: "alpha", Port: 8080, Started: now, Tags: []string{"c"}},
+		{Name: "gamma", Port: 80, Up: true, Started: now.Add(-time.Hour), Tags: []string{"a"}},
+	}
+
+	tests := []struct {
+		name      string
+		col       int
+		desc      bool
+		wantNames []string
+	}{
+		{"string asc", 0, false, []string{"alpha", "beta", "gamma"}},
+		{"string desc", 0, true, []string{"gamma", "beta", "alpha"}},
+		{"number asc", 1, false, []string{"gamma", "beta", "alpha"}},
+		{"bool asc stable", 2, false, []string{"alpha", "beta", "gamma"}},
+		{"time desc", 3, true, []string{"beta", "alpha", "gamma"}},
+		{"text asc", 4, false, []string{"gamma", "beta", "alpha"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			dt, err := g.MakeDataTable(data, Options{Sortable: true})
+			assert.NoError(t, err)
+
+			dt.Sort(tt.col, tt.desc)
+			col, desc := dt.SortColumn()
+			assert.Equal(t, tt.col, col)
+			assert.Equal(t, tt.desc, desc)
+			for row, name := range tt.wantNames {
+				assert.Equal(t, name, dt.Cell(row, 0))
+				assert.Equal(t, name, dt.CompAt(row+1, 0).(gwu.Label).Text())
+			}
+			assert.Equal(t, "alpha", data[1].Name) // the data itself is not reordered
+		})
+	}
+}
+
+func TestDataTable_sortHandler(t *testing.T) {
+	g := &GuiBuilder{}
+	dt, err := g.MakeDataTable([]testSortable{{Name: "b"}, {Name: "a"}}, Options{Sortable: true})
+	assert.NoError(t, err)
+
+	header := dt.CompAt(0, 0)
+	assert.Equal(t, gwu.CursorPointer, header.Style().Cursor())
+	assert.Equal(t, 1, header.HandlersCount(gwu.ETypeClick))
+	assert.Equal(t, 0, dt.CompAt(0, 4).HandlersCount(gwu.ETypeClick)) // nosort
+
+	e := &testEvent{}
+	dt.sortHandler(0)(e)
+	assert.Equal(t, []gwu.Comp{dt}, e.dirty)
+	assert.Equal(t, "a", dt.Cell(0, 0))
+	assert.Equal(t, "Name"+SortAscIndicator, dt.CompAt(0, 0).(gwu.Label).Text())
+
+	dt.sortHandler(0)(&testEvent{})
+	assert.Equal(t, "b", dt.Cell(0, 0))
+	assert.Equal(t, "Name"+SortDescIndicator, dt.CompAt(0, 0).(gwu.Label).Text())
+
+	dt.sortHandler(1)(&testEvent{})
+	col, desc := dt.SortColumn()
+	assert.Equal(t, 1, col)
+	assert.False(t, desc)
+	assert.Equal(t, "Name", dt.CompAt(0, 0).(gwu.Label).Text())
+
+	// the sort is kept for new data
+	dt.Sort(0, false)
+	assert.NoError(t, dt.SetData([]testSortable{{Name: "d"}, {Name: "c"}}))
+	assert.Equal(t, "c", dt.Cell(0, 0))
+
+	// headers are not clickable without the Sortable option
+	dt, err = g.MakeDataTable([]testSortable{}, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, dt.CompAt(0, 0).HandlersCount(gwu.ETypeClick))
+}
+
+func TestDataTable_SetPage(t *testing.T) {
+	var data []testSortable
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		data = append(data, testSortable{Name: name})
+	}
+
+	tests := []struct {
+		name          string
+		pageSize      int
+		page          int
+		wantPage      int
+		wantPageCount int
+		wantNames     []string
+		wantEnabled   []bool // first, prev, next, last
+	}{
+		{"no paging", 0, 1, 0, 1, []string{"a", "b", "c", "d", "e"}, nil},
+		{"first page", 2, 0, 0, 3, []string{"a", "b"}, []bool{false, false, true, true}},
+		{"middle page", 2, 1, 1, 3, []string{"c", "d"}, []bool{true, true, true, true}},
+		{"last page", 2, 2, 2, 3, []string{"e"}, []bool{true, true, false, false}},
+		{"after last page", 2, 7, 2, 3, []string{"e"}, []bool{true, true, false, false}},
+		{"before first page", 2, -1, 0, 3, []string{"a", "b"}, []bool{false, false, true, true}},
+		{"single page", 10, 0, 0, 1, []string{"a", "b", "c", "d", "e"}, []bool{false, false, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			dt, err := g.MakeDataTable(data, Options{PageSize: tt.pageSize})
+			assert.NoError(t, err)
+
+			dt.SetPage(tt.page)
+			assert.Equal(t, tt.wantPage, dt.Page())
+			assert.Equal(t, tt.wantPageCount, dt.PageCount())
+			assert.Equal(t, 5, dt.Len())
+
+			for row, name := range tt.wantNames {
+				assert.Equal(t, name, dt.CompAt(row+1, 0).(gwu.Label).Text())
+			}
+
+			pagerRow := len(tt.wantNames) + 1
+			if tt.wantEnabled == nil {
+				assert.Equal(t, pagerRow, tableRows(dt.Table))
+				return
+			}
+			assert.Equal(t, pagerRow+1, tableRows(dt.Table))
+			assert.Equal(t, 5, dt.ColSpan(pagerRow, 0))
+
+			pager := dt.CompAt(pagerRow, 0).(gwu.Panel)
+			assert.Equal(t, 5, pager.CompsCount())
+			assert.Equal(t, fmt.Sprintf("Page %d of %d", tt.wantPage+1, tt.wantPageCount), pager.CompAt(2).(gwu.Label).Text())
+			for i, comp := range []gwu.Comp{pager.CompAt(0), pager.CompAt(1), pager.CompAt(3), pager.CompAt(4)} {
+				assert.Equal(t, tt.wantEnabled[i], comp.(gwu.Button).Enabled())
+				assert.Equal(t, 1, comp.HandlersCount(gwu.ETypeClick))
+			}
+		})
+	}
+}
+
+func TestDataTable_pageHandler(t *testing.T) {
+	g := &GuiBuilder{}
+	dt, err := g.MakeDataTable([]testSortable{{Name: "b"}, {Name: "a"}, {Name: "c"}}, Options{PageSize: 2})
+	assert.NoError(t, err)
+
+	e := &testEvent{}
+	dt.pageHandler(1)(e)
+	assert.Equal(t, []gwu.Comp{dt}, e.dirty)
+	assert.Equal(t, 1, dt.Page())
+	assert.Equal(t, "c", dt.CompAt(1, 0).(gwu.Label).Text())
+
+	// shrinking data moves back to the last page
+	assert.NoError(t, dt.SetData([]testSortable{{Name: "d"}}))
+	assert.Equal(t, 0, dt.Page())
+	assert.Equal(t, "d", dt.CompAt(1, 0).(gwu.Label).Text())
+
+	dt.StylePager(Options{Color: "Red"}, Options{FontSize: "small"})
+	pager := dt.CompAt(2, 0).(gwu.Panel)
+	assert.Equal(t, "Red", pager.CompAt(0).Style().Color())
+	assert.Equal(t, "small", pager.CompAt(2).Style().FontSize())
+}
+
+type testEditable struct {
+	Name  string `wgowut:"editable"`
+	Port  int    `wgowut:"editable"`
+	Role  string `wgowut:"editable,values=admin|user"`
+	Tags  []string
+	Owner string
+}
+
+func TestDataTable_editable(t *testing.T) {
+	data := []testEditable{
+		{Name: "alpha", Port: 80, Role: "user", Tags: []string{"a"}},
+		{Name: "beta", Port: 443, Role: "admin"},
+	}
+
+	g := &GuiBuilder{}
+	dt, err := g.MakeDataTable(data, Options{})
+	assert.NoError(t, err)
+
+	name := dt.CompAt(1, 0).(gwu.TextBox)
+	assert.Equal(t, "alpha", name.Text())
+	assert.Equal(t, gwu.NewTextBox("").HandlersCount(gwu.ETypeChange)+1, name.HandlersCount(gwu.ETypeChange))
+	assert.Equal(t, "80", dt.CompAt(1, 1).(gwu.TextBox).Text())
+	role := dt.CompAt(1, 2).(gwu.ListBox)
+	assert.Equal(t, []string{"admin", "user"}, role.Values())
+	assert.Equal(t, "user", role.SelectedValue())
+	assert.Equal(t, "admin", dt.CompAt(2, 2).(gwu.ListBox).SelectedValue())
+	assert.Equal(t, "[a]", dt.CompAt(1, 3).(gwu.Label).Text())
+
+	var changes [][]interface{}
+	dt.OnCellChanged(func(row, col int, old, new string) {
+		changes = append(changes, []interface{}{row, col, old, new})
+	})
+
+	tests := []struct {
+		name       string
+		row, col   int
+		edit       func(input gwu.Comp)
+		wantChange []interface{}
+		wantDirty  bool
+		wantRevert string
+		wantValue  string
+	}{
+		{"text", 0, 0, func(c gwu.Comp) { c.(gwu.TextBox).SetText("gamma") }, []interface{}{0, 0, "alpha", "gamma"}, false, "", "gamma"},
+		{"number", 1, 1, func(c gwu.Comp) { c.(gwu.TextBox).SetText(" 8080 ") }, []interface{}{1, 1, "443", "8080"}, false, "", "8080"},
+		{"unparsable number", 0, 1, func(c gwu.Comp) { c.(gwu.TextBox).SetText("http") }, nil, true, "80", "80"},
+		{"unchanged", 0, 0, func(c gwu.Comp) {}, nil, false, "", "gamma"},
+		{"list box", 0, 2, func(c gwu.Comp) { selectValue(c.(gwu.ListBox), "admin") }, []interface{}{0, 2, "user", "admin"}, false, "", "admin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes = nil
+			input := dt.CompAt(tt.row+1, tt.col)
+			tt.edit(input)
+
+			e := &testEvent{etype: gwu.ETypeChange, src: input}
+			dt.cellHandler(tt.row, tt.col, input)(e)
+
+			if tt.wantChange == nil {
+				assert.Nil(t, changes)
+			} else {
+				assert.Equal(t, [][]interface{}{tt.wantChange}, changes)
+			}
+			if tt.wantDirty {
+				assert.Equal(t, []gwu.Comp{input}, e.dirty)
+				assert.Equal(t, tt.wantRevert, fieldValue(input))
+			} else {
+				assert.Nil(t, e.dirty)
+			}
+			assert.Equal(t, tt.wantValue, dt.Cell(tt.row, tt.col))
+		})
+	}
+
+	// edits are written back to the data
+	assert.Equal(t, testEditable{Name: "gamma", Port: 80, Role: "admin", Tags: []string{"a"}}, data[0])
+	assert.Equal(t, 8080, data[1].Port)
+	assert.Equal(t, [][]string{{"gamma", "80", "admin", "[a]", ""}, {"beta", "8080", "admin", "[]", ""}}, dt.Values())
+
+	// columns can be made editable at runtime
+	dt.SetEditable(4, nil)
+	owner := dt.CompAt(1, 4).(gwu.TextBox)
+	owner.SetText("ops")
+	dt.cellHandler(0, 4, owner)(&testEvent{})
+	assert.Equal(t, "ops", data[0].Owner)
+
+	// fields that can't be parsed into are reverted
+	dt.SetEditable(3, nil)
+	tags := dt.CompAt(1, 3).(gwu.TextBox)
+	tags.SetText("[b]")
+	dt.cellHandler(0, 3, tags)(&testEvent{})
+	assert.Equal(t, "[a]", tags.Text())
+	assert.Equal(t, []string{"a"}, data[0].Tags)
+}
+
+func TestDataTable_editableCSV(t *testing.T) {
+	g := &GuiBuilder{}
+	dt, err := g.LoadTableFromCSV(strings.NewReader("name,port\nalpha\n"), Options{})
+	assert.NoError(t, err)
+	dt.SetEditable(1, []string{"80", "443"})
+
+	port := dt.CompAt(1, 1).(gwu.ListBox)
+	assert.Equal(t, -1, port.SelectedIdx())
+	selectValue(port, "443")
+	dt.cellHandler(0, 1, port)(&testEvent{})
+	assert.Equal(t, [][]string{{"alpha", "443"}}, dt.Values())
+}
+
+func TestGuiBuilder_AddCellRule(t *testing.T) {
+	data := []testEditable{{Name: "alpha", Port: 80}, {Name: "beta", Port: 8080}}
+
+	g := &GuiBuilder{}
+	dt, err := g.MakeDataTable(data, Options{PageSize: 1})
+	assert.NoError(t, err)
+
+	var calls [][]interface{}
+	g.AddCellRule(dt, func(row, col int, value string) *Options {
+		calls = append(calls, []interface{}{row, col, value})
+		if col == 1 && len(value) > 2 {
+			return &Options{Color: "Red"}
+		}
+		return nil
+	})
+	g.AddCellRule(dt, func(row, col int, value string) *Options {
+		if col == 0 {
+			return &Options{Background: "Silver"}
+		}
+		return nil
+	})
+
+	assert.Equal(t, []interface{}{0, 0, "alpha"}, calls[0])
+	assert.Equal(t, []interface{}{0, 1, "80"}, calls[1])
+	assert.Equal(t, "", dt.CellFmt(1, 1).Style().Color())
+	assert.Equal(t, "Silver", dt.CellFmt(1, 0).Style().Background())
+	assert.Equal(t, "", dt.CellFmt(0, 0).Style().Background())
+
+	// rules get the data row of other pages
+	calls = nil
+	dt.SetPage(1)
+	assert.Equal(t, []interface{}{1, 1, "8080"}, calls[1])
+	assert.Equal(t, "Red", dt.CellFmt(1, 1).Style().Color())
+
+	// edits re-render the table
+	port := dt.CompAt(1, 1).(gwu.TextBox)
+	port.SetText("443")
+	e := &testEvent{etype: gwu.ETypeChange, src: port}
+	dt.cellHandler(1, 1, port)(e)
+	assert.Equal(t, []gwu.Comp{dt}, e.dirty)
+	assert.Equal(t, "Red", dt.CellFmt(1, 1).Style().Color())
+
+	port = dt.CompAt(1, 1).(gwu.TextBox)
+	port.SetText("44")
+	dt.cellHandler(1, 1, port)(e)
+	assert.Equal(t, "", dt.CellFmt(1, 1).Style().Color())
+	assert.Equal(t, "44", dt.CompAt(1, 1).(gwu.TextBox).Text())
+}
@@ -0,0 +1,109 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// EditableLabel is a gwu.Panel created by GuiBuilder.MakeEditableLabel that displays a label which turns into a
+// text box when clicked.
+type EditableLabel struct {
+	gwu.Panel
+
+	onCommit func(string) error
+	label    gwu.Label
+	tb       gwu.TextBox
+	errLabel gwu.Label
+	editing  bool
+}
+
+// MakeEditableLabel creates an EditableLabel with the given text. Clicking the label swaps it for a text box; pressing
+// enter or leaving the text box calls onCommit with the new text. If onCommit returns an error, it is displayed below
+// the text box and editing continues, otherwise the label is updated and displayed again. Pressing escape cancels
+// editing. The following options are used for both the label and the text box:
+//
+// WhiteSpace, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background
+func (g *GuiBuilder) MakeEditableLabel(text string, onCommit func(string) error, options Options) *EditableLabel {
+	el := &EditableLabel{
+		Panel:    g.MakePanel(Options{}),
+		onCommit: onCommit,
+		label:    g.MakeLabel(text, options),
+		tb:       g.MakeTextBox(text, options),
+		errLabel: g.MakeLabel("", Options{Color: gwu.ClrRed}),
+	}
+
+	el.label.Style().SetCursor(gwu.CursorPointer)
+	el.label.AddEHandlerFunc(el.handleClick, gwu.ETypeClick)
+
+	el.tb.Style().SetDisplay("none")
+	el.tb.AddSyncOnETypes(gwu.ETypeKeyUp, gwu.ETypeBlur)
+	el.tb.AddEHandlerFunc(el.handleKeyUp, gwu.ETypeKeyUp)
+	el.tb.AddEHandlerFunc(el.commit, gwu.ETypeBlur)
+
+	g.AddCompsToPanel(el.Panel, el.label, el.tb, el.errLabel)
+
+	return el
+}
+
+// Text returns the committed text.
+func (el *EditableLabel) Text() string {
+	return el.label.Text()
+}
+
+// SetText sets the committed text without calling onCommit.
+func (el *EditableLabel) SetText(text string) {
+	el.label.SetText(text)
+	el.tb.SetText(text)
+}
+
+// Editing tells if the text box is currently displayed.
+func (el *EditableLabel) Editing() bool {
+	return el.editing
+}
+
+func (el *EditableLabel) handleClick(e gwu.Event) {
+	el.tb.SetText(el.label.Text())
+	el.setEditing(true)
+	e.SetFocusedComp(el.tb)
+	e.MarkDirty(el)
+}
+
+func (el *EditableLabel) handleKeyUp(e gwu.Event) {
+	switch e.KeyCode() {
+	case gwu.KeyEnter:
+		el.commit(e)
+	case gwu.KeyEscape:
+		el.setEditing(false)
+		e.MarkDirty(el)
+	}
+}
+
+func (el *EditableLabel) commit(e gwu.Event) {
+	if !el.editing {
+		return
+	}
+
+	text := el.tb.Text()
+	if el.onCommit != nil {
+		if err := el.onCommit(text); err != nil {
+			el.errLabel.SetText(err.Error())
+			e.MarkDirty(el.errLabel)
+			return
+		}
+	}
+
+	el.label.SetText(text)
+	el.setEditing(false)
+	e.MarkDirty(el)
+}
+
+func (el *EditableLabel) setEditing(editing bool) {
+	el.editing = editing
+	el.errLabel.SetText("")
+	if editing {
+		el.label.Style().SetDisplay("none")
+		el.tb.Style().SetDisplay("")
+	} else {
+		el.label.Style().SetDisplay("")
+		el.tb.Style().SetDisplay("none")
+	}
+}
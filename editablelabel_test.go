@@ -0,0 +1,78 @@
+package wgowut
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeEditableLabel(t *testing.T) {
+
+	errEmpty := errors.New("name can't be empty")
+	validate := func(text string) error {
+		if text == "" {
+			return errEmpty
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name      string
+		onCommit  func(string) error
+		typed     string
+		commit    *testEvent
+		wantText  string
+		wantErr   string
+		wantLabel bool
+	}{
+		{"commit with enter", validate, "renamed", &testEvent{etype: gwu.ETypeKeyUp, key: gwu.KeyEnter}, "renamed", "", true},
+		{"commit with blur", nil, "renamed", &testEvent{etype: gwu.ETypeBlur}, "renamed", "", true},
+		{"validation error keeps editing", validate, "", &testEvent{etype: gwu.ETypeBlur}, "server", errEmpty.Error(), false},
+		{"escape cancels", validate, "renamed", &testEvent{etype: gwu.ETypeKeyUp, key: gwu.KeyEscape}, "server", "", true},
+		{"other keys ignored", validate, "renamed", &testEvent{etype: gwu.ETypeKeyUp, key: gwu.Key('a')}, "server", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			options := Options{FontSize: "20px", Color: gwu.ClrNavy}
+			got := g.MakeEditableLabel("server", tt.onCommit, options)
+
+			checkStyle(t, got.label.Style(), options)
+			assert.Equal(t, "none", got.tb.Style().Display())
+
+			e := &testEvent{etype: gwu.ETypeClick, src: got.label}
+			got.handleClick(e)
+			assert.Equal(t, true, got.Editing())
+			assert.Equal(t, "none", got.label.Style().Display())
+			assert.Equal(t, "", got.tb.Style().Display())
+			assert.Equal(t, got.tb, e.focused)
+
+			got.tb.SetText(tt.typed)
+			if tt.commit.etype == gwu.ETypeBlur {
+				got.commit(tt.commit)
+			} else {
+				got.handleKeyUp(tt.commit)
+			}
+
+			assert.Equal(t, tt.wantText, got.Text())
+			assert.Equal(t, tt.wantErr, got.errLabel.Text())
+			assert.Equal(t, !tt.wantLabel, got.Editing())
+		})
+	}
+}
+
+func TestEditableLabel_SetText(t *testing.T) {
+	g := &GuiBuilder{}
+	committed := false
+	got := g.MakeEditableLabel("old", func(string) error { committed = true; return nil }, Options{})
+
+	got.SetText("new")
+	assert.Equal(t, "new", got.Text())
+	assert.Equal(t, "new", got.tb.Text())
+	assert.Equal(t, false, committed)
+
+	got.commit(&testEvent{etype: gwu.ETypeBlur})
+	assert.Equal(t, false, committed)
+}
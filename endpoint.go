@@ -0,0 +1,43 @@
+package wgowut
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// endpointPrefix is the path, relative to the app path, under which the wgowut endpoints, like the one receiving the
+// files of upload widgets, are served.
+const endpointPrefix = "_wgowut/"
+
+// endpoints holds the mux of the wgowut endpoints, by path relative to endpointPrefix, and the app paths it is
+// registered under, as http.DefaultServeMux panics on duplicates.
+var endpoints = struct {
+	sync.Mutex
+	mux      *http.ServeMux
+	appPaths map[string]bool
+}{mux: http.NewServeMux(), appPaths: make(map[string]bool)}
+
+// endpointPath registers the wgowut endpoints under the app path of a server with http.DefaultServeMux, which is where
+// gwu servers listen, and returns the path of the endpoint with the given name, like "/app/_wgowut/upload/".
+func endpointPath(appPath, name string) string {
+	prefix := appPath + endpointPrefix
+
+	endpoints.Lock()
+	if !endpoints.appPaths[prefix] {
+		endpoints.appPaths[prefix] = true
+		http.Handle(prefix, http.StripPrefix(strings.TrimSuffix(prefix, "/"), endpoints.mux))
+	}
+	endpoints.Unlock()
+
+	return prefix + name
+}
+
+// newToken returns a random token for the path of an endpoint, so the endpoints of widgets can't be guessed.
+func newToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,72 @@
+package wgowut
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// ErrorListBackground is the default background of error lists made by MakeErrorList.
+const ErrorListBackground = "#FFF0F0"
+
+// MakeErrorList creates an expander listing errs, for validation summaries and task failures. The header tells the
+// number of errors and collapses or expands the list, which is expanded initially. An error wrapping other errors (see
+// errors.Unwrap) is displayed as an expander itself, revealing the messages of the wrapped errors as details. The list
+// is hidden if errs is empty.
+//
+// The options are used like in MakePanel (except Layout); Color defaults to ClrRed and Background to
+// ErrorListBackground, and a red border is set unless a BorderWidth is given.
+func (g *GuiBuilder) MakeErrorList(errs []error, options Options) gwu.Expander {
+	if options.Color == "" {
+		options.Color = gwu.ClrRed
+	}
+	if options.Background == "" {
+		options.Background = ErrorListBackground
+	}
+	if options.BorderWidth == 0 {
+		options.BorderWidth, options.BorderStyle, options.BorderColor = 1, gwu.BrdStyleSolid, gwu.ClrRed
+	}
+
+	list := gwu.NewExpander()
+	setTableView(list, options)
+	setStyle(list.Style(), g.styleOptions(options))
+
+	header := "1 error"
+	if len(errs) != 1 {
+		header = fmt.Sprintf("%d errors", len(errs))
+	}
+	list.SetHeader(g.MakeLabel(header, Options{Bold: true}))
+
+	content := g.MakePanel(Options{Layout: LayoutVertical, CellPadding: options.CellPadding})
+	for _, err := range errs {
+		content.Add(g.makeErrorItem(err))
+	}
+	list.SetContent(content)
+	list.SetExpanded(true)
+
+	if len(errs) == 0 {
+		list.Style().SetDisplay(gwu.DisplayNone)
+	}
+
+	return list
+}
+
+// makeErrorItem returns the component displaying err in an error list: a label, or an expander with the messages of
+// the wrapped errors if err wraps any.
+func (g *GuiBuilder) makeErrorItem(err error) gwu.Comp {
+	label := g.MakeLabel(err.Error(), Options{})
+	wrapped := errors.Unwrap(err)
+	if wrapped == nil {
+		return label
+	}
+
+	details := g.MakePanel(Options{Layout: LayoutVertical})
+	for ; wrapped != nil; wrapped = errors.Unwrap(wrapped) {
+		details.Add(g.MakeLabel("caused by: "+wrapped.Error(), Options{FontSize: "smaller"}))
+	}
+	item := gwu.NewExpander()
+	item.SetHeader(label)
+	item.SetContent(details)
+	return item
+}
@@ -0,0 +1,43 @@
+package wgowut
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeErrorList(t *testing.T) {
+	g := &GuiBuilder{}
+	notFound := errors.New("not found")
+	errs := []error{errors.New("name is required"), fmt.Errorf("load config: %w", fmt.Errorf("open file: %w", notFound))}
+	list := g.MakeErrorList(errs, Options{CellPadding: 4})
+
+	assert.True(t, list.Expanded())
+	assert.Equal(t, "2 errors", list.Header().(gwu.Label).Text())
+	assert.Equal(t, gwu.ClrRed, list.Style().Color())
+	assert.Equal(t, ErrorListBackground, list.Style().Background())
+	assert.Equal(t, "1px solid "+gwu.ClrRed, list.Style().Border())
+	assert.Equal(t, 4, list.CellPadding())
+
+	content := list.Content().(gwu.Panel)
+	assert.Equal(t, 2, content.CompsCount())
+	assert.Equal(t, "name is required", content.CompAt(0).(gwu.Label).Text())
+
+	item := content.CompAt(1).(gwu.Expander)
+	assert.False(t, item.Expanded())
+	assert.Equal(t, "load config: open file: not found", item.Header().(gwu.Label).Text())
+	details := item.Content().(gwu.Panel)
+	assert.Equal(t, 2, details.CompsCount())
+	assert.Equal(t, "caused by: open file: not found", details.CompAt(0).(gwu.Label).Text())
+	assert.Equal(t, "caused by: not found", details.CompAt(1).(gwu.Label).Text())
+
+	list = g.MakeErrorList(errs[:1], Options{Color: gwu.ClrMaroon, BorderWidth: 2})
+	assert.Equal(t, "1 error", list.Header().(gwu.Label).Text())
+	assert.Equal(t, gwu.ClrMaroon, list.Style().Color())
+	assert.Equal(t, "", list.Style().Display())
+
+	assert.Equal(t, gwu.DisplayNone, g.MakeErrorList(nil, Options{}).Style().Display())
+}
@@ -0,0 +1,56 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// subscription is a function subscribed to a topic with Subscribe.
+type subscription struct {
+	fn func(payload interface{}, e gwu.Event)
+}
+
+// Subscribe subscribes fn to topic: fn is called with the payload and the event of each Publish to topic, so a
+// component can update others without holding references to them. It returns a function unsubscribing fn.
+//
+// The subscriptions belong to g, so subscribe with a session builder (see NewSessionBuilder) when fn updates the
+// components of a session; subscriptions of a builder shared by sessions are called for the publishes of all of them.
+func (g *GuiBuilder) Subscribe(topic string, fn func(payload interface{}, e gwu.Event)) (unsubscribe func()) {
+	sub := &subscription{fn: fn}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.topics == nil {
+		g.topics = make(map[string][]*subscription)
+	}
+	g.topics[topic] = append(g.topics[topic], sub)
+
+	return func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		subs := g.topics[topic]
+		for i, s := range subs {
+			if s == sub {
+				g.topics[topic] = append(subs[:i:i], subs[i+1:]...)
+				break
+			}
+		}
+		if len(g.topics[topic]) == 0 {
+			delete(g.topics, topic)
+		}
+	}
+}
+
+// Publish calls the functions subscribed to topic with Subscribe, in the order they subscribed, with payload and e.
+// Publish from event handlers, passing the event being handled, so the subscribers can mark the components they
+// update dirty, or nil outside of event handlers. Subscribers may subscribe and publish themselves.
+func (g *GuiBuilder) Publish(e gwu.Event, topic string, payload interface{}) {
+	g.mu.Lock()
+	subs := g.topics[topic]
+	g.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.fn(payload, e)
+	}
+}
@@ -0,0 +1,44 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_Publish(t *testing.T) {
+	g := &GuiBuilder{}
+	label := g.MakeLabel("", Options{})
+	var calls []string
+
+	unsubscribe := g.Subscribe("user", func(payload interface{}, e gwu.Event) {
+		label.SetText(payload.(string))
+		e.MarkDirty(label)
+		calls = append(calls, "label")
+	})
+	g.Subscribe("user", func(payload interface{}, e gwu.Event) {
+		calls = append(calls, "second")
+		g.Publish(e, "audit", payload)
+	})
+	g.Subscribe("audit", func(payload interface{}, e gwu.Event) { calls = append(calls, "audit") })
+
+	e := &testEvent{etype: gwu.ETypeChange}
+	g.Publish(e, "user", "bob")
+	assert.Equal(t, "bob", label.Text())
+	assert.Equal(t, []gwu.Comp{label}, e.dirty)
+	assert.Equal(t, []string{"label", "second", "audit"}, calls)
+
+	calls = nil
+	unsubscribe()
+	unsubscribe()
+	g.Publish(nil, "user", "alice")
+	g.Publish(nil, "unknown", nil)
+	assert.Equal(t, []string{"second", "audit"}, calls)
+	assert.Equal(t, "bob", label.Text())
+
+	// session builders have their own subscriptions
+	other := NewSessionBuilder(newTestSession("a"))
+	other.Publish(nil, "audit", nil)
+	assert.Equal(t, []string{"second", "audit"}, calls)
+}
@@ -0,0 +1,153 @@
+package wgowut
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// OnClick adds fn to comp as its click handler, sparing the gwu.ETypeClick of AddEHandlerFunc. A nil fn is ignored.
+func (g *GuiBuilder) OnClick(comp gwu.Comp, fn func(gwu.Event)) {
+	if fn != nil {
+		comp.AddEHandlerFunc(fn, gwu.ETypeClick)
+	}
+}
+
+// MakeButtonWithHandler creates a button with MakeButton and adds onClick to it as its click handler.
+func (g *GuiBuilder) MakeButtonWithHandler(text string, options Options, onClick func(gwu.Event)) gwu.Button {
+	btn := g.MakeButton(text, options)
+	g.OnClick(btn, onClick)
+	return btn
+}
+
+// addOptionHandlers adds the handlers of options to comp: OnClick, OnChange to list boxes and text boxes, and OnEnter
+// to text boxes.
+func addOptionHandlers(comp gwu.Comp, options Options) {
+	if options.OnClick != nil {
+		comp.AddEHandlerFunc(options.OnClick, gwu.ETypeClick)
+	}
+
+	switch comp := comp.(type) {
+	case gwu.TextBox:
+		if options.OnEnter != nil {
+			bindEnterKeyFunc(comp, options.OnEnter)
+		}
+	case gwu.ListBox:
+	default:
+		return
+	}
+	if options.OnChange != nil {
+		comp.AddEHandlerFunc(options.OnChange, gwu.ETypeChange)
+	}
+}
+
+// BindEnterKey makes pressing Enter in tb click submit, so Enter submits search boxes and forms like users expect.
+// The text of tb is sent to the server before the click, so the click handlers of submit see it. tb must not have key
+// down handlers, which would conflict with the script doing this.
+func (g *GuiBuilder) BindEnterKey(tb gwu.TextBox, submit gwu.Button) {
+	tb.SetAttr("onkeydown", fmt.Sprintf("if(event.keyCode==%d){var x=new XMLHttpRequest();x.open('POST',_pathEvent,false);"+
+		"x.setRequestHeader('Content-type','application/x-www-form-urlencoded');"+
+		"x.send(_pEventType+'=%d&'+_pCompId+'=%d&'+_pCompValue+'='+encodeURIComponent(this.value));procEresp(x);"+
+		"var b=document.getElementById('%d');if(b)b.click();return false;}",
+		int(gwu.KeyEnter), int(gwu.ETypeChange), int(tb.ID()), int(submit.ID())))
+}
+
+// BindEnterKeyFunc makes pressing Enter in tb call fn, with the text of tb synced. It's the same as the OnEnter option
+// of MakeTextBox.
+func (g *GuiBuilder) BindEnterKeyFunc(tb gwu.TextBox, fn func(gwu.Event)) {
+	bindEnterKeyFunc(tb, fn)
+}
+
+// bindEnterKeyFunc makes pressing Enter in tb call fn.
+func bindEnterKeyFunc(tb gwu.TextBox, fn func(gwu.Event)) {
+	tb.AddSyncOnETypes(gwu.ETypeKeyUp)
+	tb.AddEHandlerFunc(enterHandler(fn), gwu.ETypeKeyUp)
+}
+
+// enterHandler returns a key up handler calling fn when the key is Enter.
+func enterHandler(fn func(gwu.Event)) func(gwu.Event) {
+	return func(e gwu.Event) {
+		if e.KeyCode() == gwu.KeyEnter {
+			fn(e)
+		}
+	}
+}
+
+// EventHandlerFunc is a handler function that implements gwu.EventHandler, so it can be added with both AddEHandler and
+// AddEHandlerFunc; gwu only has an unexported adapter.
+type EventHandlerFunc func(e gwu.Event)
+
+// HandleEvent calls f.
+func (f EventHandlerFunc) HandleEvent(e gwu.Event) {
+	f(e)
+}
+
+// Debounce returns a handler calling fn once a burst of events (like key ups while typing) pauses for d, with the last
+// event of the burst, so an expensive fn like a live filter runs once, with the final value. The events of the burst
+// still sync the values of their components. The call is made by a hidden timer added to the window of the source of
+// the events, whose event stands in for the last event: its Src is the source of that event. Adding the timer
+// re-renders the window on the first event, keeping the focus on the source. Events of sources not in a window are
+// handled at once, as are all events if d is 0. To also save the round trips of a text box, use the DebounceChange
+// option of MakeTextBox instead.
+func Debounce(d time.Duration, fn func(gwu.Event)) EventHandlerFunc {
+	var mu sync.Mutex
+	timers := make(map[gwu.ID]*debounceTimer)
+
+	return func(e gwu.Event) {
+		root := eventRoot(e)
+		if d <= 0 || root == nil {
+			fn(e)
+			return
+		}
+
+		mu.Lock()
+		t := timers[root.ID()]
+		if t == nil {
+			t = &debounceTimer{Timer: gwu.NewTimer(d), fn: fn}
+			t.AddEHandlerFunc(t.fire, gwu.ETypeStateChange)
+			root.Add(t)
+			timers[root.ID()] = t
+			e.MarkDirty(root)
+			e.SetFocusedComp(e.Src())
+		} else {
+			e.MarkDirty(t)
+		}
+		t.src = e.Src()
+		t.SetActive(true)
+		t.Reset()
+		mu.Unlock()
+	}
+}
+
+// debounceTimer is the timer of a window calling the function of a Debounce handler once a burst of events pauses.
+type debounceTimer struct {
+	gwu.Timer
+	fn  func(gwu.Event)
+	src gwu.Comp // src is the source of the last event
+}
+
+// debouncedEvent is the event of a debounce timer, standing in for the last event of a burst.
+type debouncedEvent struct {
+	gwu.Event
+	src gwu.Comp
+}
+
+// Src returns the source of the last event of the burst.
+func (e debouncedEvent) Src() gwu.Comp {
+	return e.src
+}
+
+// fire calls the function of the handler, and deactivates the timer so it doesn't fire again when re-rendered.
+func (t *debounceTimer) fire(e gwu.Event) {
+	t.SetActive(false)
+	t.fn(debouncedEvent{Event: e, src: t.src})
+}
+
+// debounceChange makes tb send its change event from the browser once the user stops typing for delay. The listener
+// is an attribute of the text box, so it survives re-renders of it.
+func debounceChange(tb gwu.TextBox, delay time.Duration) {
+	tb.SetAttr("oninput", fmt.Sprintf("clearTimeout(this._t);var e=this;this._t=setTimeout(function(){"+
+		"se(null,%d,%s,encodeURIComponent(e.value));},%d);", gwu.ETypeChange, tb.ID(), delay.Milliseconds()))
+}
@@ -0,0 +1,147 @@
+package wgowut
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_OnClick(t *testing.T) {
+	g := &GuiBuilder{}
+	label := g.MakeLabel("x", Options{})
+	g.OnClick(label, func(e gwu.Event) {})
+	g.OnClick(label, nil)
+
+	assert.Equal(t, 1, label.HandlersCount(gwu.ETypeClick))
+	assert.Equal(t, 0, label.HandlersCount(gwu.ETypeChange))
+}
+
+func TestGuiBuilder_MakeButtonWithHandler(t *testing.T) {
+	g := &GuiBuilder{}
+	btn := g.MakeButtonWithHandler("Save", Options{Color: gwu.ClrRed}, func(e gwu.Event) {})
+
+	assert.Equal(t, "Save", btn.Text())
+	assert.Equal(t, gwu.ClrRed, btn.Style().Color())
+	assert.Equal(t, 1, btn.HandlersCount(gwu.ETypeClick))
+
+	assert.Equal(t, 0, g.MakeButtonWithHandler("Cancel", Options{}, nil).HandlersCount(gwu.ETypeClick))
+}
+
+func TestOptionHandlers(t *testing.T) {
+	g := &GuiBuilder{}
+	fn := func(e gwu.Event) {}
+	options := Options{OnClick: fn, OnChange: fn, OnEnter: fn}
+
+	tests := []struct {
+		name                 string
+		make                 func(options Options) gwu.Comp
+		click, change, keyUp int
+	}{
+		{"button", func(options Options) gwu.Comp { return g.MakeButton("x", options) }, 1, 0, 0},
+		{"label", func(options Options) gwu.Comp { return g.MakeLabel("x", options) }, 1, 0, 0},
+		{"list box", func(options Options) gwu.Comp { return g.MakeListBox([]string{"x"}, options) }, 1, 1, 0},
+		{"text box", func(options Options) gwu.Comp { return g.MakeTextBox("x", options) }, 1, 1, 2}, // syncing on key up adds a handler
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp, plain := tt.make(options), tt.make(Options{})
+			assert.Equal(t, tt.click, comp.HandlersCount(gwu.ETypeClick)-plain.HandlersCount(gwu.ETypeClick))
+			assert.Equal(t, tt.change, comp.HandlersCount(gwu.ETypeChange)-plain.HandlersCount(gwu.ETypeChange))
+			assert.Equal(t, tt.keyUp, comp.HandlersCount(gwu.ETypeKeyUp)-plain.HandlersCount(gwu.ETypeKeyUp))
+		})
+	}
+	assert.Contains(t, g.MakeTextBox("", Options{OnEnter: fn}).SyncOnETypes(), gwu.ETypeKeyUp)
+	assert.NotContains(t, g.MakeTextBox("", Options{}).SyncOnETypes(), gwu.ETypeKeyUp)
+}
+
+func TestEnterHandler(t *testing.T) {
+	var calls int
+	handler := enterHandler(func(e gwu.Event) { calls++ })
+
+	handler(&testEvent{etype: gwu.ETypeKeyUp, key: gwu.KeyA})
+	assert.Equal(t, 0, calls)
+	handler(&testEvent{etype: gwu.ETypeKeyUp, key: gwu.KeyEnter})
+	assert.Equal(t, 1, calls)
+}
+
+func TestDebounce(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "Main", Options{})
+	tb := g.MakeTextBox("", Options{})
+	win.Add(tb)
+
+	var srcs []gwu.Comp
+	handler := Debounce(300*time.Millisecond, func(e gwu.Event) { srcs = append(srcs, e.Src()) })
+
+	// the first event adds the timer to the window, the next ones restart it
+	e := &testEvent{etype: gwu.ETypeKeyUp, src: tb}
+	handler(e)
+	assert.Nil(t, srcs)
+	assert.Equal(t, 2, win.CompsCount())
+	timer := win.CompAt(1).(*debounceTimer)
+	assert.Equal(t, 300*time.Millisecond, timer.Timeout())
+	assert.Equal(t, []gwu.Comp{tb.Parent()}, e.dirty)
+	assert.Equal(t, gwu.Comp(tb), e.focused)
+
+	e = &testEvent{etype: gwu.ETypeKeyUp, src: tb}
+	handler(e)
+	assert.Nil(t, srcs)
+	assert.Equal(t, 2, win.CompsCount())
+	assert.Equal(t, []gwu.Comp{timer}, e.dirty)
+	assert.True(t, timer.Active())
+
+	// the timer calls fn once with the source of the last event
+	timer.fire(&testEvent{etype: gwu.ETypeStateChange, src: timer})
+	assert.Equal(t, []gwu.Comp{tb}, srcs)
+	assert.False(t, timer.Active())
+
+	// without a window or delay, events are handled at once
+	handler(&testEvent{etype: gwu.ETypeKeyUp})
+	assert.Len(t, srcs, 2)
+	handler = Debounce(0, func(e gwu.Event) { srcs = append(srcs, e.Src()) })
+	handler(&testEvent{etype: gwu.ETypeKeyUp, src: tb})
+	assert.Len(t, srcs, 3)
+
+	// the handler can also be added with AddEHandler
+	var _ gwu.EventHandler = handler
+}
+
+func TestGuiBuilder_MakeTextBox_DebounceChange(t *testing.T) {
+	g := &GuiBuilder{}
+	tb := g.MakeTextBox("x", Options{DebounceChange: 300 * time.Millisecond, Width: "80px"})
+
+	assert.Equal(t, "x", tb.Text())
+	assert.Equal(t, "80px", tb.Style().Width())
+	script := tb.Attr("oninput")
+	assert.Contains(t, script, "clearTimeout(this._t);")
+	assert.Contains(t, script, fmt.Sprintf("se(null,%d,%s,encodeURIComponent(e.value));},300);", gwu.ETypeChange, tb.ID()))
+	assert.NotContains(t, script, `"`)
+
+	// the listener is rendered with the text box itself, so re-rendering it keeps the listener
+	html := renderHTML(tb)
+	assert.Contains(t, html, `<input type="text"`)
+	assert.Contains(t, html, "oninput=")
+	assert.NotContains(t, html, "<script>")
+
+	assert.Empty(t, g.MakeTextBox("x", Options{}).Attr("oninput"))
+}
+
+func TestGuiBuilder_BindEnterKey(t *testing.T) {
+	g := &GuiBuilder{}
+	tb, btn := g.MakeTextBox("", Options{}), g.MakeButton("Search", Options{})
+	g.BindEnterKey(tb, btn)
+
+	script := tb.Attr("onkeydown")
+	assert.Contains(t, script, fmt.Sprintf("if(event.keyCode==%d){", gwu.KeyEnter))
+	assert.Contains(t, script, fmt.Sprintf("x.send(_pEventType+'=%d&'+_pCompId+'=%s&'", gwu.ETypeChange, tb.ID()))
+	assert.Contains(t, script, fmt.Sprintf("document.getElementById('%s');if(b)b.click();return false;}", btn.ID()))
+	assert.NotContains(t, script, `"`)
+
+	tb = g.MakeTextBox("", Options{})
+	g.BindEnterKeyFunc(tb, func(e gwu.Event) {})
+	assert.Contains(t, tb.SyncOnETypes(), gwu.ETypeKeyUp)
+	assert.Equal(t, 2, tb.HandlersCount(gwu.ETypeKeyUp)) // syncing on key up adds a handler
+}
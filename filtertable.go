@@ -0,0 +1,103 @@
+package wgowut
+
+import (
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// FilterDelay is the time a FilterableDataTable waits after the last key stroke before filtering the rows (see the
+// DebounceChange option).
+const FilterDelay = 300 * time.Millisecond
+
+// FilterableDataTable is a gwu.Panel created by GuiBuilder.MakeFilterableDataTable holding a search text box above a
+// DataTable.
+type FilterableDataTable struct {
+	gwu.Panel
+
+	table      *DataTable
+	filter     gwu.TextBox
+	colFilters []gwu.TextBox
+}
+
+// MakeFilterableDataTable creates a DataTable with MakeDataTable and a search text box above it. Once the user stops
+// typing for FilterDelay (or presses enter or leaves the box), only the rows with a column containing the search text are displayed,
+// ignoring case. If the ColumnFilters option is set, a row of text boxes is added under the headers to filter single
+// columns the same way; with the Columns option, only the columns with Filterable set get one. The options are passed
+// to MakeDataTable.
+func (g *GuiBuilder) MakeFilterableDataTable(data interface{}, options Options) (*FilterableDataTable, error) {
+	table, err := g.MakeDataTable(data, options)
+	if err != nil {
+		return nil, err
+	}
+
+	ft := &FilterableDataTable{
+		Panel:  g.MakePanel(Options{Layout: LayoutVertical}),
+		table:  table,
+		filter: g.MakeTextBox("", Options{DebounceChange: FilterDelay}),
+	}
+	ft.filter.SetAttr("placeholder", "Search")
+	setAriaLabel(ft.filter, "Search")
+
+	for col, column := range table.columns {
+		if !column.filterable {
+			continue
+		}
+		if ft.colFilters == nil {
+			ft.colFilters = make([]gwu.TextBox, len(table.columns))
+			table.filterRow = make([]gwu.Comp, len(table.columns))
+		}
+		tb := g.MakeTextBox("", Options{Width: FullWidth, DebounceChange: FilterDelay})
+		setAriaLabel(tb, "Filter "+column.header)
+		ft.colFilters[col] = tb
+		table.filterRow[col] = tb
+	}
+	if ft.colFilters != nil {
+		table.render()
+	}
+
+	for _, tb := range append([]gwu.TextBox{ft.filter}, ft.colFilters...) {
+		if tb == nil {
+			continue
+		}
+		tb.AddEHandlerFunc(ft.applyFilters, gwu.ETypeChange)
+	}
+
+	g.AddCompsToPanel(ft.Panel, ft.filter, table)
+
+	return ft, nil
+}
+
+// Table returns the filtered DataTable.
+func (ft *FilterableDataTable) Table() *DataTable {
+	return ft.table
+}
+
+// FilterBox returns the search text box filtering all columns.
+func (ft *FilterableDataTable) FilterBox() gwu.TextBox {
+	return ft.filter
+}
+
+// ColumnFilterBox returns the filter text box of the column, or nil if the column isn't filterable.
+func (ft *FilterableDataTable) ColumnFilterBox(col int) gwu.TextBox {
+	if col < 0 || col >= len(ft.colFilters) {
+		return nil
+	}
+	return ft.colFilters[col]
+}
+
+// applyFilters sets the filters of the table from the text boxes and marks the table dirty.
+func (ft *FilterableDataTable) applyFilters(e gwu.Event) {
+	ft.table.filter = normalizeFilter(ft.filter.Text())
+	ft.table.colFilters = make(map[int]string)
+	for col, tb := range ft.colFilters {
+		if tb == nil {
+			continue
+		}
+		if text := normalizeFilter(tb.Text()); text != "" {
+			ft.table.colFilters[col] = text
+		}
+	}
+	ft.table.update()
+	e.MarkDirty(ft.table)
+}
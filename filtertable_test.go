@@ -0,0 +1,97 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeFilterableDataTable(t *testing.T) {
+	data := []testServer{
+		{Name: "alpha", Port: 80},
+		{Name: "Beta", Port: 8080},
+		{Name: "gamma", Port: 443},
+	}
+
+	tests := []struct {
+		name          string
+		columnFilters bool
+		filter        string
+		colFilters    map[int]string
+		wantNames     []string
+	}{
+		{"no filter", false, "", nil, []string{"alpha", "Beta", "gamma"}},
+		{"any column ignoring case", false, " BET ", nil, []string{"Beta"}},
+		{"any column number", false, "80", nil, []string{"alpha", "Beta"}},
+		{"no match", false, "delta", nil, nil},
+		{"column filter", true, "", map[int]string{1: "80"}, []string{"alpha", "Beta"}},
+		{"column filter excludes other columns", true, "", map[int]string{0: "80"}, nil},
+		{"column and search filters", true, "a", map[int]string{1: "80"}, []string{"alpha", "Beta"}},
+		{"two column filters", true, "", map[int]string{0: "al", 1: "80"}, []string{"alpha"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			ft, err := g.MakeFilterableDataTable(data, Options{ColumnFilters: tt.columnFilters})
+			assert.NoError(t, err)
+
+			assert.Equal(t, 2, ft.CompsCount())
+			assert.Equal(t, ft.FilterBox(), ft.CompAt(0))
+			assert.Equal(t, ft.Table(), ft.CompAt(1))
+			assert.Nil(t, ft.ColumnFilterBox(-1))
+
+			first := 1
+			if tt.columnFilters {
+				first = 2
+				for col := range ft.Table().Columns() {
+					assert.Equal(t, ft.ColumnFilterBox(col), ft.Table().CompAt(1, col))
+				}
+			} else {
+				assert.Nil(t, ft.ColumnFilterBox(0))
+			}
+
+			ft.FilterBox().SetText(tt.filter)
+			for col, text := range tt.colFilters {
+				ft.ColumnFilterBox(col).SetText(text)
+			}
+
+			// the text boxes only send change events once typing pauses
+			assert.Contains(t, ft.FilterBox().Attr("oninput"), "},300);")
+			assert.Equal(t, 3, ft.Table().Len())
+
+			e := &testEvent{etype: gwu.ETypeChange, src: ft.FilterBox()}
+			ft.applyFilters(e)
+			assert.Equal(t, []gwu.Comp{ft.Table()}, e.dirty)
+
+			assert.Equal(t, len(tt.wantNames), ft.Table().Len())
+			assert.Equal(t, first+len(tt.wantNames), tableRows(ft.Table().Table))
+			for row, name := range tt.wantNames {
+				assert.Equal(t, name, ft.Table().Cell(row, 0))
+				assert.Equal(t, name, ft.Table().CompAt(first+row, 0).(gwu.Label).Text())
+			}
+		})
+	}
+}
+
+func TestFilterableDataTable_page(t *testing.T) {
+	g := &GuiBuilder{}
+	ft, err := g.MakeFilterableDataTable([]testServer{{Name: "alpha"}, {Name: "beta"}}, Options{PageSize: 1})
+	assert.NoError(t, err)
+
+	ft.Table().SetPage(1)
+	ft.FilterBox().SetText("alpha")
+	e := &testEvent{etype: gwu.ETypeChange, src: ft.FilterBox()}
+	ft.applyFilters(e)
+	assert.Equal(t, []gwu.Comp{ft.Table()}, e.dirty)
+	assert.Equal(t, 1, ft.Table().Len())
+	assert.Equal(t, 0, ft.Table().Page())
+
+	// the filter is kept for new data
+	assert.NoError(t, ft.Table().SetData([]testServer{{Name: "alphabet"}, {Name: "gamma"}}))
+	assert.Equal(t, 1, ft.Table().Len())
+	assert.Equal(t, "alphabet", ft.Table().Cell(0, 0))
+
+	ft.Table().SetFilter("")
+	assert.Equal(t, 2, ft.Table().Len())
+}
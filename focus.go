@@ -0,0 +1,24 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// SetInitialFocus focuses comp every time win is loaded (including reloads), so users can start typing immediately in
+// search and login windows. The focus is set by an ETypeWinLoad handler of the window; if it's called again for the
+// window, the comp of the last call is focused. Use the AutoFocus option if the window isn't at hand when the component
+// is made.
+func (g *GuiBuilder) SetInitialFocus(win gwu.Window, comp gwu.Comp) {
+	win.AddEHandlerFunc(initialFocusHandler(comp), gwu.ETypeWinLoad)
+}
+
+func initialFocusHandler(comp gwu.Comp) func(gwu.Event) {
+	return func(e gwu.Event) {
+		e.SetFocusedComp(comp)
+	}
+}
+
+// autoFocus makes the browser focus comp when the page holding it is loaded.
+func autoFocus(comp gwu.Comp) {
+	comp.SetAttr("autofocus", "autofocus")
+}
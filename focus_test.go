@@ -0,0 +1,34 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_SetInitialFocus(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("login", "Login", Options{})
+	user, passw := g.MakeTextBox("", Options{}), gwu.NewPasswBox("")
+	win.Add(user)
+	win.Add(passw)
+
+	g.SetInitialFocus(win, user)
+	assert.Equal(t, 1, win.HandlersCount(gwu.ETypeWinLoad))
+	e := &testEvent{etype: gwu.ETypeWinLoad}
+	initialFocusHandler(user)(e)
+	assert.Equal(t, user, e.focused)
+}
+
+func TestOptions_AutoFocus(t *testing.T) {
+	g := &GuiBuilder{}
+	for _, comp := range []gwu.Comp{
+		g.MakeTextBox("", Options{AutoFocus: true}),
+		g.MakeListBox([]string{"a"}, Options{AutoFocus: true}),
+		g.MakeButton("OK", Options{AutoFocus: true}),
+	} {
+		assert.Equal(t, "autofocus", comp.Attr("autofocus"))
+	}
+	assert.Equal(t, "", g.MakeTextBox("", Options{}).Attr("autofocus"))
+}
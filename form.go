@@ -0,0 +1,197 @@
+package wgowut
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// InputKind is used to set the kind of input created for a Field
+type InputKind int
+
+// InputKind constants, a TextBox is created if the kind is not specified
+const (
+	InputTextBox InputKind = iota
+	InputPasswBox
+	InputListBox
+	InputCheckBox
+)
+
+// RequiredMarker is appended to the label text of required fields.
+const RequiredMarker = " *"
+
+// Field defines a single label and input row of a Form. Name is used to access the input after the form is built
+// and defaults to the Label text if left blank. Text is the initial text of text and password boxes and the text of
+// check boxes, Values are the values of list boxes. Options are passed to the Make function used for the input and
+// LabelOptions to MakeLabel. Validators are attached with Form.AddValidators.
+type Field struct {
+	Name         string
+	Label        string
+	Kind         InputKind
+	Text         string
+	Values       []string
+	Required     bool
+	Options      Options
+	LabelOptions Options
+	Validators   []Validator
+}
+
+// Form is a gwu.Table of labels and inputs created by GuiBuilder.MakeForm. The inputs can be accessed by field name.
+type Form struct {
+	gwu.Table
+	fields     []Field
+	inputs     map[string]gwu.Comp
+	labels     map[string]gwu.Label
+	errLabels  map[string]gwu.Label
+	validators map[string][]Validator
+	submit     gwu.Button
+}
+
+// MakeForm creates a Form with one row per field: the label is right aligned in the first column and the input is
+// left aligned in the second column. Labels of required fields have the RequiredMarker appended. Fields with
+// validators get a third column for the error label. Inputs are associated with their labels (see LabelFor), or get
+// the field name as their ARIA label if the label is blank, and required inputs are marked with aria-required. The
+// table is made with MakeTable and uses the same options, Rows and Cols are set from the fields.
+func (g *GuiBuilder) MakeForm(options Options, fields ...Field) *Form {
+	options.Rows, options.Cols = len(fields), 2
+	form := &Form{
+		Table:      g.MakeTable(options),
+		inputs:     make(map[string]gwu.Comp),
+		labels:     make(map[string]gwu.Label),
+		errLabels:  make(map[string]gwu.Label),
+		validators: make(map[string][]Validator),
+	}
+
+	for _, field := range fields {
+		g.addFormField(form, field)
+	}
+
+	return form
+}
+
+func (g *GuiBuilder) addFormField(form *Form, field Field) {
+	if field.Name == "" {
+		field.Name = field.Label
+	}
+	row := len(form.fields)
+	form.fields = append(form.fields, field)
+	form.EnsureSize(row+1, 2)
+
+	labelText := field.Label
+	if field.Required {
+		labelText += RequiredMarker
+	}
+	label := g.MakeLabel(labelText, field.LabelOptions)
+	form.labels[field.Name] = label
+	form.Add(label, row, 0)
+	form.CellFmt(row, 0).SetAlign(gwu.HARight, gwu.VAMiddle)
+
+	input := g.makeFieldInput(field)
+	form.inputs[field.Name] = input
+	form.Add(input, row, 1)
+	form.CellFmt(row, 1).SetAlign(gwu.HALeft, gwu.VAMiddle)
+
+	if field.Label != "" {
+		g.LabelFor(label, input)
+	} else {
+		setAriaLabel(input, field.Name)
+	}
+	if field.Required {
+		input.SetAttr("aria-required", "true")
+		form.AddValidators(field.Name, Required())
+	}
+	form.AddValidators(field.Name, field.Validators...)
+}
+
+func (g *GuiBuilder) makeFieldInput(field Field) gwu.Comp {
+	switch field.Kind {
+	case InputPasswBox:
+		pb := gwu.NewPasswBox(field.Text)
+		setEnabled(pb, field.Options.Enable)
+		pb.SetReadOnly(field.Options.ReadOnly)
+		setStyle(pb.Style(), g.styleOptions(field.Options))
+		g.enlargeTarget(pb)
+		return pb
+	case InputListBox:
+		options := field.Options
+		if options.Rows == 0 {
+			options.Rows = 1
+		}
+		return g.MakeListBox(field.Values, options)
+	case InputCheckBox:
+		cb := gwu.NewCheckBox(field.Text)
+		setEnabled(cb, field.Options.Enable)
+		setStyle(cb.Style(), g.styleOptions(field.Options))
+		g.enlargeTarget(cb)
+		return cb
+	}
+	return g.MakeTextBox(field.Text, field.Options)
+}
+
+// Fields returns the field definitions of the form in order.
+func (f *Form) Fields() []Field {
+	return f.fields
+}
+
+// Input returns the input component of the named field, or nil if there is no such field.
+func (f *Form) Input(name string) gwu.Comp {
+	return f.inputs[name]
+}
+
+// Label returns the label of the named field, or nil if there is no such field.
+func (f *Form) Label(name string) gwu.Label {
+	return f.labels[name]
+}
+
+// TextBox returns the input of the named field if it is a text or password box, otherwise nil.
+func (f *Form) TextBox(name string) gwu.TextBox {
+	tb, _ := f.inputs[name].(gwu.TextBox)
+	return tb
+}
+
+// ListBox returns the input of the named field if it is a list box, otherwise nil.
+func (f *Form) ListBox(name string) gwu.ListBox {
+	lb, _ := f.inputs[name].(gwu.ListBox)
+	return lb
+}
+
+// CheckBox returns the input of the named field if it is a check box, otherwise nil.
+func (f *Form) CheckBox(name string) gwu.CheckBox {
+	cb, _ := f.inputs[name].(gwu.CheckBox)
+	return cb
+}
+
+// Values returns the current values of all inputs by field name. Text and password boxes give their text, list boxes
+// their selected value (multiple selected values are joined with commas), and check boxes "true" or "false".
+func (f *Form) Values() map[string]string {
+	values := make(map[string]string, len(f.fields))
+	for _, field := range f.fields {
+		values[field.Name] = fieldValue(f.inputs[field.Name])
+	}
+	return values
+}
+
+func fieldValue(input gwu.Comp) string {
+	switch in := input.(type) {
+	case gwu.CheckBox:
+		return strconv.FormatBool(in.State())
+	case gwu.ListBox:
+		return strings.Join(in.SelectedValues(), ",")
+	case gwu.TextBox:
+		return in.Text()
+	}
+	return ""
+}
+
+// WireSubmit adds an ETypeClick handler to btn that gathers the current values of all inputs of the form (see
+// Form.Values) and passes them to fn.
+func (g *GuiBuilder) WireSubmit(btn gwu.Button, form *Form, fn func(values map[string]string, e gwu.Event)) {
+	btn.AddEHandlerFunc(submitHandler(form, fn), gwu.ETypeClick)
+}
+
+func submitHandler(form *Form, fn func(values map[string]string, e gwu.Event)) func(e gwu.Event) {
+	return func(e gwu.Event) {
+		fn(form.Values(), e)
+	}
+}
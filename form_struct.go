@@ -0,0 +1,239 @@
+package wgowut
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// TagName is the struct tag key read by BuildForm.
+const TagName = "wgowut"
+
+// ErrNotStruct is returned by BuildForm and Form.Collect when the value is not a struct or a pointer to a struct.
+var ErrNotStruct = errors.New("wgowut: value must be a struct or a pointer to a struct")
+
+// BuildForm creates a Form with MakeForm from the exported fields of the struct v (or pointer to a struct), using the
+// current field values as initial values. Fields of string, bool, int, uint and float kinds are supported, fields of
+// other kinds, like slices, nested structs or time.Time, are skipped. The field name is used as the Form field name and
+// as the default label. The optional struct tag is a comma separated list:
+//
+//	label=Text       the label text
+//	widget=Kind      one of textbox (the default), password, listbox, or checkbox (the default for bools)
+//	values=a|b|c     the values of a listbox
+//	required         mark the field as required
+//
+// A tag of "-" skips the field. For example:
+//
+//	type user struct {
+//		Name  string `wgowut:"label=Full name,required"`
+//		Role  string `wgowut:"widget=listbox,values=admin|user"`
+//		Admin bool
+//	}
+func (g *GuiBuilder) BuildForm(v interface{}, options Options) (*Form, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []Field
+	var checked []string
+	for i := 0; i < rv.NumField(); i++ {
+		sf := rv.Type().Field(i)
+		tag, ok := sf.Tag.Lookup(TagName)
+		if sf.PkgPath != "" || tag == "-" || !supportedKind(sf.Type.Kind()) {
+			continue
+		}
+
+		field, err := structField(sf, tag, ok)
+		if err != nil {
+			return nil, err
+		}
+
+		fv := rv.Field(i)
+		if field.Kind == InputCheckBox {
+			if fv.Bool() {
+				checked = append(checked, field.Name)
+			}
+		} else {
+			field.Text = formatValue(fv)
+		}
+
+		fields = append(fields, field)
+	}
+
+	form := g.MakeForm(options, fields...)
+
+	for _, field := range fields {
+		if lb := form.ListBox(field.Name); lb != nil {
+			for i, value := range field.Values {
+				if value == field.Text {
+					lb.ClearSelected()
+					lb.SetSelected(i, true)
+				}
+			}
+		}
+	}
+	for _, name := range checked {
+		form.CheckBox(name).SetState(true)
+	}
+
+	return form, nil
+}
+
+func structValue(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, ErrNotStruct
+	}
+	return rv, nil
+}
+
+// supportedKind tells if BuildForm makes a form field for struct fields of kind k.
+func supportedKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool, reflect.String, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func structField(sf reflect.StructField, tag string, hasTag bool) (Field, error) {
+	field := Field{Name: sf.Name, Label: sf.Name}
+	if sf.Type.Kind() == reflect.Bool {
+		field.Kind = InputCheckBox
+	}
+
+	if !hasTag {
+		return field, nil
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		switch kv[0] {
+		case "":
+		case "required":
+			field.Required = true
+		case "label", "widget", "values":
+			if len(kv) != 2 {
+				return field, fmt.Errorf("wgowut: missing value for %q in tag of field %s", kv[0], sf.Name)
+			}
+			switch kv[0] {
+			case "label":
+				field.Label = kv[1]
+			case "values":
+				field.Values = strings.Split(kv[1], "|")
+			case "widget":
+				switch kv[1] {
+				case "textbox":
+					field.Kind = InputTextBox
+				case "password":
+					field.Kind = InputPasswBox
+				case "listbox":
+					field.Kind = InputListBox
+				case "checkbox":
+					field.Kind = InputCheckBox
+				default:
+					return field, fmt.Errorf("wgowut: unknown widget %q in tag of field %s", kv[1], sf.Name)
+				}
+			}
+		default:
+			return field, fmt.Errorf("wgowut: unknown key %q in tag of field %s", kv[0], sf.Name)
+		}
+	}
+
+	if field.Kind == InputCheckBox && sf.Type.Kind() != reflect.Bool {
+		return field, fmt.Errorf("wgowut: checkbox widget requires a bool field, %s is %v", sf.Name, sf.Type.Kind())
+	}
+
+	return field, nil
+}
+
+func formatValue(fv reflect.Value) string {
+	switch fv.Kind() {
+	case reflect.String:
+		return fv.String()
+	case reflect.Bool:
+		return strconv.FormatBool(fv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(fv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(fv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(fv.Float(), 'g', -1, fv.Type().Bits())
+	}
+	return ""
+}
+
+// Collect reads the current input values of the form back into the matching fields of the struct pointed to by v,
+// which is normally the same struct passed to BuildForm. Struct fields without a matching form field are left
+// unchanged. An error is returned if a value can't be parsed into the field's kind, the remaining fields are still set.
+func (f *Form) Collect(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr {
+		return ErrNotStruct
+	}
+	rv, err := structValue(v)
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	for i := 0; i < rv.NumField(); i++ {
+		sf := rv.Type().Field(i)
+		input := f.Input(sf.Name)
+		if sf.PkgPath != "" || input == nil {
+			continue
+		}
+
+		if err := parseValue(rv.Field(i), fieldValue(input)); err != nil {
+			errs = append(errs, fmt.Sprintf("field %s: %v", sf.Name, err))
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("wgowut: could not collect form values: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func parseValue(fv reflect.Value, text string) error {
+	if fv.Kind() == reflect.String {
+		fv.SetString(text)
+		return nil
+	}
+
+	text = strings.TrimSpace(text)
+	switch fv.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		fl, err := strconv.ParseFloat(text, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(fl)
+	}
+	return nil
+}
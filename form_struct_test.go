@@ -0,0 +1,128 @@
+package wgowut
+
+import (
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+type testFormStruct struct {
+	Name     string `wgowut:"label=Full name,required"`
+	Password string `wgowut:"widget=password"`
+	Role     string `wgowut:"widget=listbox,values=admin|user|guest"`
+	Age      int
+	Quota    uint8
+	Ratio    float64
+	Admin    bool
+	Skipped  string `wgowut:"-"`
+	hidden   string
+
+	// fields of unsupported kinds are skipped
+	Tags    []string
+	Created time.Time
+	Address struct{ City string }
+}
+
+func TestGuiBuilder_BuildForm(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{"struct pointer", &testFormStruct{Name: "bob", Password: "pw", Role: "user", Age: 30, Quota: 5, Ratio: 0.5, Admin: true}, false},
+		{"struct value", testFormStruct{Role: "guest"}, false},
+		{"not a struct", "string", true},
+		{"unknown tag key", &struct {
+			Name string `wgowut:"size=3"`
+		}{}, true},
+		{"unknown widget", &struct {
+			Name string `wgowut:"widget=slider"`
+		}{}, true},
+		{"checkbox widget on string", &struct {
+			Name string `wgowut:"widget=checkbox"`
+		}{}, true},
+		{"missing tag value", &struct {
+			Name string `wgowut:"label"`
+		}{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			got, err := g.BuildForm(tt.v, Options{CellPadding: 2})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			want, ok := tt.v.(*testFormStruct)
+			if !ok {
+				value := tt.v.(testFormStruct)
+				want = &value
+			}
+
+			assert.Equal(t, 2, got.CellPadding())
+			assert.Equal(t, 7, len(got.Fields()))
+			assert.Equal(t, "Full name"+RequiredMarker, got.Label("Name").Text())
+			assert.Equal(t, want.Name, got.TextBox("Name").Text())
+			assert.Equal(t, want.Password, got.TextBox("Password").Text())
+			assert.Equal(t, []string{"admin", "user", "guest"}, got.ListBox("Role").Values())
+			assert.Equal(t, want.Role, got.ListBox("Role").SelectedValue())
+			assert.Equal(t, want.Admin, got.CheckBox("Admin").State())
+			assert.Nil(t, got.Input("Skipped"))
+			assert.Nil(t, got.Input("hidden"))
+			assert.Nil(t, got.Input("Tags"))
+			assert.Nil(t, got.Input("Created"))
+			assert.Nil(t, got.Input("Address"))
+		})
+	}
+}
+
+func TestForm_Collect(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		set     func(f *Form)
+		want    testFormStruct
+		wantErr bool
+	}{
+		{"collect all values", func(f *Form) {
+			f.TextBox("Name").SetText("alice")
+			f.TextBox("Age").SetText(" 42 ")
+			f.TextBox("Quota").SetText("7")
+			f.TextBox("Ratio").SetText("1.5")
+			f.ListBox("Role").SetSelectedIndices([]int{0})
+			f.CheckBox("Admin").SetState(true)
+		}, testFormStruct{Name: "alice", Password: "pw", Role: "admin", Age: 42, Quota: 7, Ratio: 1.5, Admin: true, Skipped: "skip", Tags: []string{"go"}}, false},
+		{"parse errors", func(f *Form) {
+			f.TextBox("Name").SetText("carol")
+			f.TextBox("Age").SetText("old")
+			f.TextBox("Quota").SetText("300")
+		}, testFormStruct{Name: "carol", Password: "pw", Role: "user", Age: 1, Quota: 2, Ratio: 0.25, Skipped: "skip", Tags: []string{"go"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			v := &testFormStruct{Password: "pw", Role: "user", Age: 1, Quota: 2, Ratio: 0.25, Skipped: "skip", Tags: []string{"go"}}
+			form, err := g.BuildForm(v, Options{})
+			assert.NoError(t, err)
+
+			tt.set(form)
+			err = form.Collect(v)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, *v)
+		})
+	}
+
+	form := (&GuiBuilder{}).MakeForm(Options{}, Field{Name: "Name"})
+	assert.Equal(t, ErrNotStruct, form.Collect(testFormStruct{}))
+	assert.Equal(t, ErrNotStruct, form.Collect(new(string)))
+	assert.IsType(t, gwu.NewTextBox(""), form.Input("Name"))
+}
@@ -0,0 +1,99 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeForm(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		options Options
+		fields  []Field
+	}{
+		{"all input kinds", Options{CellPadding: 3, BorderWidth: 1, BorderStyle: gwu.BrdStyleSolid, BorderColor: gwu.ClrBlack}, []Field{
+			{Name: "user", Label: "User", Text: "bob", Required: true, Options: Options{Width: "1"}},
+			{Name: "pass", Label: "Password", Kind: InputPasswBox, Options: Options{Enable: EnableFalse}},
+			{Name: "role", Label: "Role", Kind: InputListBox, Values: []string{"admin", "user"}},
+			{Label: "Remember", Kind: InputCheckBox, Text: "remember me", LabelOptions: Options{Color: gwu.ClrGray}},
+		}},
+		{"no fields", Options{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			got := g.MakeForm(tt.options, tt.fields...)
+
+			checkTableView(t, got.Table.(gwu.TableView), tt.options)
+			checkStyle(t, got.Style(), tt.options)
+			assert.Equal(t, len(tt.fields), len(got.Fields()))
+
+			for row, field := range tt.fields {
+				name := field.Name
+				if name == "" {
+					name = field.Label
+				}
+
+				label := got.Label(name)
+				assert.Equal(t, label, got.CompAt(row, 0))
+				if field.Required {
+					assert.Equal(t, field.Label+RequiredMarker, label.Text())
+				} else {
+					assert.Equal(t, field.Label, label.Text())
+				}
+				checkStyle(t, label.Style(), field.LabelOptions)
+				assert.Equal(t, gwu.HAlign(gwu.HARight), got.CellFmt(row, 0).HAlign())
+
+				input := got.Input(name)
+				assert.Equal(t, input, got.CompAt(row, 1))
+				assert.Equal(t, gwu.HAlign(gwu.HALeft), got.CellFmt(row, 1).HAlign())
+				checkStyle(t, input.Style(), field.Options)
+
+				switch field.Kind {
+				case InputTextBox, InputPasswBox:
+					assert.Equal(t, field.Text, got.TextBox(name).Text())
+					checkEnabled(t, got.TextBox(name), field.Options)
+				case InputListBox:
+					assert.Equal(t, field.Values, got.ListBox(name).Values())
+					assert.Equal(t, 1, got.ListBox(name).Rows())
+				case InputCheckBox:
+					assert.Equal(t, field.Text, got.CheckBox(name).Text())
+				}
+			}
+
+			assert.Nil(t, got.Input("missing"))
+			assert.Nil(t, got.TextBox("missing"))
+			assert.Nil(t, got.ListBox("missing"))
+			assert.Nil(t, got.CheckBox("missing"))
+		})
+	}
+}
+
+func TestGuiBuilder_WireSubmit(t *testing.T) {
+	g := &GuiBuilder{}
+	form := g.MakeForm(Options{},
+		Field{Name: "name", Label: "Name", Text: "bob"},
+		Field{Name: "roles", Label: "Roles", Kind: InputListBox, Values: []string{"admin", "dev", "ops"}, Options: Options{Multi: true}},
+		Field{Name: "active", Label: "Active", Kind: InputCheckBox},
+	)
+	form.ListBox("roles").SetSelectedIndices([]int{0, 2})
+
+	btn := g.MakeButton("Submit", Options{})
+	handlers := btn.HandlersCount(gwu.ETypeClick)
+
+	var got map[string]string
+	var gotEvent gwu.Event
+	fn := func(values map[string]string, e gwu.Event) {
+		got, gotEvent = values, e
+	}
+	g.WireSubmit(btn, form, fn)
+	assert.Equal(t, handlers+1, btn.HandlersCount(gwu.ETypeClick))
+
+	e := &testEvent{etype: gwu.ETypeClick, src: btn}
+	submitHandler(form, fn)(e)
+	assert.Equal(t, map[string]string{"name": "bob", "roles": "admin,ops", "active": "false"}, got)
+	assert.Equal(t, e, gotEvent)
+}
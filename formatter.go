@@ -0,0 +1,67 @@
+package wgowut
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// formatters holds the value formatters registered with RegisterFormatter by name.
+var formatters = struct {
+	sync.RWMutex
+	fns map[string]func(interface{}) string
+}{fns: make(map[string]func(interface{}) string)}
+
+// RegisterFormatter registers fn as the named value formatter, so a presentation rule (like "bytes" or "percent") can
+// be defined once and used by name: by DataTable columns with the "formatter" tag key (for example
+// `wgowut:"label=Size,formatter=bytes"`), by MakeFormattedLabel and by the formatter attribute of span elements in
+// ParseHTMLLayout. Formatters are shared by all builders. Registering a name again replaces the formatter, and a nil fn
+// removes it.
+func (g *GuiBuilder) RegisterFormatter(name string, fn func(interface{}) string) {
+	formatters.Lock()
+	defer formatters.Unlock()
+
+	if fn == nil {
+		delete(formatters.fns, name)
+		return
+	}
+	formatters.fns[name] = fn
+}
+
+// lookupFormatter returns the named formatter, or nil if there is none.
+func lookupFormatter(name string) func(interface{}) string {
+	formatters.RLock()
+	defer formatters.RUnlock()
+
+	return formatters.fns[name]
+}
+
+// Format formats value with the named formatter. value is formatted with fmt if there is no such formatter.
+func (g *GuiBuilder) Format(name string, value interface{}) string {
+	if fn := lookupFormatter(name); fn != nil {
+		return fn(value)
+	}
+	return fmt.Sprint(value)
+}
+
+// MakeFormattedLabel creates a label displaying value formatted with the named formatter (see RegisterFormatter). The
+// label is made with MakeLabel and uses the same options.
+func (g *GuiBuilder) MakeFormattedLabel(value interface{}, formatter string, options Options) gwu.Label {
+	return g.MakeLabel(g.Format(formatter, value), options)
+}
+
+// formatterValue returns the value passed to a formatter for text from a layout: an int64 or float64 if the text is a
+// number, the text otherwise.
+func formatterValue(text string) interface{} {
+	text = strings.TrimSpace(text)
+	if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return f
+	}
+	return text
+}
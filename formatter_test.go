@@ -0,0 +1,100 @@
+package wgowut
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBytesFormatter(v interface{}) string {
+	switch n := v.(type) {
+	case int64:
+		return fmt.Sprintf("%.1f KiB", float64(n)/1024)
+	case int:
+		return fmt.Sprintf("%.1f KiB", float64(n)/1024)
+	}
+	return fmt.Sprintf("? %v", v)
+}
+
+type testFile struct {
+	Name string
+	Size int    `wgowut:"label=Size,formatter=testBytes"`
+	Note string `wgowut:"editable,formatter=testBytes"`
+}
+
+func TestGuiBuilder_Format(t *testing.T) {
+	g := &GuiBuilder{}
+	g.RegisterFormatter("testBytes", testBytesFormatter)
+	defer g.RegisterFormatter("testBytes", nil)
+
+	tests := []struct {
+		name      string
+		formatter string
+		value     interface{}
+		want      string
+	}{
+		{"registered", "testBytes", 1536, "1.5 KiB"},
+		{"registered other type", "testBytes", "x", "? x"},
+		{"unknown formatter", "testMissing", 1536, "1536"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, g.Format(tt.formatter, tt.value))
+			assert.Equal(t, tt.want, g.MakeFormattedLabel(tt.value, tt.formatter, Options{}).Text())
+		})
+	}
+
+	g.RegisterFormatter("testBytes", nil)
+	assert.Equal(t, "1536", g.Format("testBytes", 1536))
+}
+
+func TestGuiBuilder_MakeDataTable_formatter(t *testing.T) {
+	g := &GuiBuilder{}
+	g.RegisterFormatter("testBytes", testBytesFormatter)
+	defer g.RegisterFormatter("testBytes", nil)
+
+	dt, err := g.MakeDataTable([]testFile{{"a.txt", 2048, "n"}, {"b.txt", 512, "m"}}, Options{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "2.0 KiB", dt.Cell(0, 1))
+	assert.Equal(t, "2.0 KiB", dt.CompAt(1, 1).(gwu.Label).Text())
+	// editable columns display the raw value
+	assert.Equal(t, "n", dt.Cell(0, 2))
+	assert.Equal(t, "n", dt.CompAt(1, 2).(gwu.TextBox).Text())
+
+	dt.SetFilter("0.5 kib")
+	assert.Equal(t, 1, dt.Len())
+	assert.Equal(t, "b.txt", dt.Cell(0, 0))
+}
+
+func TestGuiBuilder_ParseHTMLLayout_formatter(t *testing.T) {
+	g := &GuiBuilder{}
+	g.RegisterFormatter("testBytes", testBytesFormatter)
+	defer g.RegisterFormatter("testBytes", nil)
+
+	tests := []struct {
+		name    string
+		html    string
+		want    string
+		wantErr bool
+	}{
+		{"number", `<span formatter="testBytes">1024</span>`, "1.0 KiB", false},
+		{"text", `<span formatter="testBytes">big</span>`, "? big", false},
+		{"no formatter", `<span>1024</span>`, "1024", false},
+		{"unknown formatter", `<span formatter="testMissing">1024</span>`, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, _, err := g.ParseHTMLLayout(strings.NewReader(tt.html))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, root.(gwu.Label).Text())
+		})
+	}
+}
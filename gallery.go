@@ -0,0 +1,72 @@
+package wgowut
+
+import (
+	"path"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// ThumbnailHeight is the default height of the thumbnails of image galleries made by MakeImageGallery.
+const ThumbnailHeight = "120px"
+
+// GalleryBreakpoints are the default breakpoints of image galleries, from a single column on phones to 6 on wide
+// screens.
+var GalleryBreakpoints = []Breakpoint{{0, 1}, {480, 2}, {800, 4}, {1200, 6}}
+
+// ImageGallery is a Grid of image thumbnails created by GuiBuilder.MakeImageGallery.
+type ImageGallery struct {
+	*Grid
+	urls    []string
+	onClick func(e gwu.Event, index int, url string)
+}
+
+// MakeImageGallery creates an image gallery laying thumbnails of the images at urls into a grid, with the file name of
+// each image as its alternate text. The grid is made with MakeGrid using options; its columns follow the viewport width
+// (see GalleryBreakpoints) unless Cols is set. The thumbnails are scaled to the Height of options, ThumbnailHeight by
+// default, and call the function set with OnClick when clicked.
+func (g *GuiBuilder) MakeImageGallery(urls []string, options Options) *ImageGallery {
+	height, cols := options.Height, options.Cols
+	if height == "" {
+		height = ThumbnailHeight
+	}
+	options.Height = ""
+	if options.HAlign == "" {
+		options.HAlign = gwu.HACenter
+	}
+
+	ig := &ImageGallery{urls: append([]string(nil), urls...)}
+	if cols > 0 {
+		ig.Grid = g.MakeGrid(cols, options)
+	} else {
+		ig.Grid = g.MakeGrid(GalleryBreakpoints[0].Cols, options)
+		ig.SetBreakpoints(GalleryBreakpoints...)
+	}
+
+	for i, url := range ig.urls {
+		img := gwu.NewImage(path.Base(url), url)
+		img.Style().SetHeight(height).Set("max-width", "100%").Set("object-fit", "cover").SetCursor(gwu.CursorPointer)
+		img.AddEHandlerFunc(ig.clickHandler(i), gwu.ETypeClick)
+		ig.Add(img)
+	}
+
+	return ig
+}
+
+// OnClick sets the function called with the index and URL of the image whose thumbnail is clicked, for example to
+// open the full image in a dialog.
+func (ig *ImageGallery) OnClick(fn func(e gwu.Event, index int, url string)) {
+	ig.onClick = fn
+}
+
+// URLs returns the URLs of the images of the gallery.
+func (ig *ImageGallery) URLs() []string {
+	return append([]string(nil), ig.urls...)
+}
+
+func (ig *ImageGallery) clickHandler(i int) func(gwu.Event) {
+	return func(e gwu.Event) {
+		if ig.onClick != nil {
+			ig.onClick(e, i, ig.urls[i])
+		}
+	}
+}
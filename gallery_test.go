@@ -0,0 +1,40 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeImageGallery(t *testing.T) {
+	g := &GuiBuilder{}
+	urls := []string{"/shots/login.png", "/shots/home.png", "/shots/settings.png"}
+	ig := g.MakeImageGallery(urls, Options{CellPadding: 4})
+
+	assert.Equal(t, urls, ig.URLs())
+	assert.Equal(t, 1, ig.Cols())
+	assert.True(t, ig.Resize(1000))
+	assert.Equal(t, 4, ig.Cols())
+	assert.Equal(t, 4, ig.Table().(gwu.TableView).CellPadding())
+
+	img := ig.Table().CompAt(0, 1).(gwu.Image)
+	assert.Equal(t, "/shots/home.png", img.URL())
+	assert.Equal(t, "home.png", img.Text())
+	assert.Equal(t, ThumbnailHeight, img.Style().Height())
+
+	var index int
+	var url string
+	ig.OnClick(func(e gwu.Event, i int, u string) { index, url = i, u })
+	ig.clickHandler(2)(&testEvent{etype: gwu.ETypeClick, src: img})
+	assert.Equal(t, 2, index)
+	assert.Equal(t, "/shots/settings.png", url)
+
+	// fixed columns
+	ig = g.MakeImageGallery(urls, Options{Cols: 2, Height: "80px"})
+	assert.Equal(t, 2, ig.Cols())
+	assert.False(t, ig.Resize(1000))
+	assert.Equal(t, "80px", ig.Table().CompAt(0, 0).Style().Height())
+	assert.Equal(t, "", ig.Table().(gwu.Table).Style().Height())
+	ig.clickHandler(0)(&testEvent{etype: gwu.ETypeClick}) // no OnClick set
+}
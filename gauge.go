@@ -0,0 +1,105 @@
+package wgowut
+
+import (
+	"fmt"
+	"html"
+	"math"
+
+	"github.com/icza/gowut/gwu"
+)
+
+const (
+	// GaugeWidth is the default width of gauges made by MakeGauge.
+	GaugeWidth = "160px"
+	// GaugeColor is the default color of the filled part of gauges.
+	GaugeColor = ProgressBarColor
+)
+
+// Gauge displays a value between a minimum and a maximum as a partly filled semicircle or bar, drawn with inline SVG.
+// Create it with MakeGauge.
+type Gauge struct {
+	gwu.HTML
+	min, max, value float64
+	color, track    string
+	bar             bool
+}
+
+// MakeGauge creates a gauge displaying value in the range from min to max, for dashboards. The gauge is a semicircle
+// filled from the left, or a horizontal bar filled from the left if the Layout option is LayoutHorizontal; the value
+// is displayed in it. Color is the color of the filled part, GaugeColor by default, and Background the color of the
+// rest, ClrSilver by default. Width defaults to GaugeWidth; the height follows the width. The FontSize option is also
+// used.
+func (g *GuiBuilder) MakeGauge(value, min, max float64, options Options) *Gauge {
+	if max < min {
+		max = min
+	}
+	ga := &Gauge{HTML: gwu.NewHTML(""), min: min, max: max, color: options.Color, bar: options.Layout == LayoutHorizontal}
+	if ga.color == "" {
+		ga.color = GaugeColor
+	}
+	if ga.track = options.Background; ga.track == "" {
+		ga.track = gwu.ClrSilver
+	}
+	if options.Width == "" {
+		options.Width = GaugeWidth
+	}
+	options.Color, options.Background, options.Height = "", "", ""
+	setStyle(ga.Style(), g.styleOptions(options))
+	ga.Style().SetDisplay("inline-block")
+	ga.SetAttr("role", "meter")
+	ga.SetAttr("aria-valuemin", formatNumber(min))
+	ga.SetAttr("aria-valuemax", formatNumber(max))
+
+	ga.SetValue(nil, value)
+	return ga
+}
+
+// Value returns the value displayed by the gauge.
+func (ga *Gauge) Value() float64 {
+	return ga.value
+}
+
+// SetValue sets the value displayed by the gauge, clamped to its range, and marks the gauge dirty if e is not nil.
+func (ga *Gauge) SetValue(e gwu.Event, value float64) {
+	if value < ga.min {
+		value = ga.min
+	} else if value > ga.max {
+		value = ga.max
+	}
+	ga.value = value
+	ga.SetAttr("aria-valuenow", formatNumber(value))
+	ga.SetHTML(ga.svg())
+
+	if e != nil {
+		e.MarkDirty(ga)
+	}
+}
+
+// fraction returns the filled fraction of the gauge, from 0 to 1.
+func (ga *Gauge) fraction() float64 {
+	if ga.max == ga.min {
+		return 0
+	}
+	return (ga.value - ga.min) / (ga.max - ga.min)
+}
+
+// svg returns the SVG image of the gauge.
+func (ga *Gauge) svg() string {
+	text := html.EscapeString(formatNumber(ga.value))
+	f := ga.fraction()
+
+	if ga.bar {
+		return fmt.Sprintf(`<svg viewBox="0 0 100 20" width="100%%">`+
+			`<rect width="100" height="20" rx="3" fill="%s"/><rect width="%.2f" height="20" rx="3" fill="%s"/>`+
+			`<text x="50" y="14" font-size="10" text-anchor="middle">%s</text></svg>`,
+			ga.track, 100*f, ga.color, text)
+	}
+
+	// the value arc ends on the semicircle of radius 40 around (50, 50), at angle f*180° from its left end
+	x, y := 50-40*math.Cos(math.Pi*f), 50-40*math.Sin(math.Pi*f)
+	return fmt.Sprintf(`<svg viewBox="0 0 100 60" width="100%%">`+
+		`<path d="M10 50 A40 40 0 0 1 90 50" fill="none" stroke="%s" stroke-width="10"/>`+
+		`<path d="M10 50 A40 40 0 0 1 %.2f %.2f" fill="none" stroke="%s" stroke-width="10"/>`+
+		`<text x="50" y="48" font-size="14" text-anchor="middle">%s</text></svg>`,
+		ga.track, x, y, ga.color, text)
+}
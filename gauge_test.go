@@ -0,0 +1,45 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeGauge(t *testing.T) {
+	g := &GuiBuilder{}
+	ga := g.MakeGauge(50, 0, 200, Options{})
+
+	assert.Equal(t, 50.0, ga.Value())
+	assert.Equal(t, GaugeWidth, ga.Style().Width())
+	assert.Equal(t, "meter", ga.Attr("role"))
+	assert.Equal(t, "200", ga.Attr("aria-valuemax"))
+	assert.Equal(t, "50", ga.Attr("aria-valuenow"))
+	assert.Contains(t, ga.HTML.HTML(), `<path d="M10 50 A40 40 0 0 1 90 50" fill="none" stroke="`+gwu.ClrSilver+`"`)
+	assert.Contains(t, ga.HTML.HTML(), `<path d="M10 50 A40 40 0 0 1 21.72 21.72" fill="none" stroke="`+GaugeColor+`"`)
+	assert.Contains(t, ga.HTML.HTML(), `>50</text>`)
+
+	e := &testEvent{}
+	ga.SetValue(e, 250)
+	assert.Equal(t, 200.0, ga.Value())
+	assert.Equal(t, []gwu.Comp{ga}, e.dirty)
+	assert.Contains(t, ga.HTML.HTML(), `0 0 1 90.00 50.00"`)
+	ga.SetValue(nil, -5)
+	assert.Equal(t, 0.0, ga.Value())
+	assert.Contains(t, ga.HTML.HTML(), `0 0 1 10.00 50.00"`)
+}
+
+func TestGuiBuilder_MakeGauge_Bar(t *testing.T) {
+	g := &GuiBuilder{}
+	ga := g.MakeGauge(0.25, 0, 1, Options{Layout: LayoutHorizontal, Color: gwu.ClrRed, Background: gwu.ClrWhite, Width: "300px"})
+
+	assert.Equal(t, "300px", ga.Style().Width())
+	assert.Equal(t, "", ga.Style().Color())
+	assert.Equal(t, "", ga.Style().Background())
+	assert.Contains(t, ga.HTML.HTML(), `<rect width="100" height="20" rx="3" fill="`+gwu.ClrWhite+`"/>`)
+	assert.Contains(t, ga.HTML.HTML(), `<rect width="25.00" height="20" rx="3" fill="`+gwu.ClrRed+`"/>`)
+	assert.Contains(t, ga.HTML.HTML(), `>0.25</text>`)
+
+	assert.Equal(t, 0.0, g.MakeGauge(3, 3, 3, Options{}).fraction())
+}
@@ -0,0 +1,122 @@
+package wgowut
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// Breakpoint sets the number of columns of a Grid for viewports at least MinWidth pixels wide.
+type Breakpoint struct {
+	MinWidth int // MinWidth is the smallest viewport width in pixels the breakpoint applies to.
+	Cols     int // Cols is the number of columns of the grid.
+}
+
+// Grid lays out components in a table, flowing them into the cells left to right and wrapping them to new rows, so
+// card-style dashboards don't need row and column arithmetic. Create it with MakeGrid.
+type Grid struct {
+	gwu.Panel
+	table       gwu.Table
+	defaultCols int
+	cols        int
+	comps       []gwu.Comp
+	breakpoints []Breakpoint
+	width       gwu.TextBox
+}
+
+// MakeGrid creates a grid of cols columns (at least 1). The table holding the components is made with MakeTable and
+// uses the same options except Rows and Cols; the columns have equal widths.
+func (g *GuiBuilder) MakeGrid(cols int, options Options) *Grid {
+	if cols < 1 {
+		cols = 1
+	}
+	options.Rows, options.Cols = 0, 0
+
+	gr := &Grid{Panel: gwu.NewPanel(), table: g.MakeTable(options), defaultCols: cols, cols: cols}
+	gr.Panel.Add(gr.table)
+	return gr
+}
+
+// Add adds comp to the grid, in the cell after the last component.
+func (gr *Grid) Add(comp gwu.Comp) {
+	gr.comps = append(gr.comps, comp)
+	gr.place(len(gr.comps) - 1)
+}
+
+// place adds the component of index i to its cell.
+func (gr *Grid) place(i int) {
+	row, col := i/gr.cols, i%gr.cols
+	gr.table.Add(gr.comps[i], row, col)
+	gr.table.CellFmt(row, col).Style().SetWidth(strconv.Itoa(100/gr.cols) + "%")
+}
+
+// Table returns the table holding the components of the grid.
+func (gr *Grid) Table() gwu.Table {
+	return gr.table
+}
+
+// Cols returns the current number of columns of the grid.
+func (gr *Grid) Cols() int {
+	return gr.cols
+}
+
+// SetBreakpoints sets the number of columns by viewport width. The grid reports the viewport width of the browser when
+// the window is loaded and resized, and reflows its components with the columns of the breakpoint with the largest
+// MinWidth not above the width, or with the columns passed to MakeGrid if there is none (so a breakpoint with MinWidth
+// 0 replaces them).
+func (gr *Grid) SetBreakpoints(breakpoints ...Breakpoint) {
+	gr.breakpoints = append([]Breakpoint(nil), breakpoints...)
+	sort.Slice(gr.breakpoints, func(i, j int) bool { return gr.breakpoints[i].MinWidth < gr.breakpoints[j].MinWidth })
+
+	if gr.width == nil {
+		gr.width = &widthBox{gwu.NewTextBox("")}
+		gr.width.Style().SetDisplay(gwu.DisplayNone)
+		gr.width.AddEHandlerFunc(gr.widthHandler, gwu.ETypeChange)
+		gr.Panel.Add(gr.width)
+	}
+}
+
+func (gr *Grid) widthHandler(e gwu.Event) {
+	width, err := strconv.Atoi(gr.width.Text())
+	if err == nil && gr.Resize(width) {
+		e.MarkDirty(gr)
+	}
+}
+
+// Resize reflows the components of the grid for a viewport width pixels wide (see SetBreakpoints), and reports if the
+// number of columns changed. It's called with the width reported by the browser, so it's only needed to size the grid
+// for a known width up front.
+func (gr *Grid) Resize(width int) bool {
+	cols := gr.defaultCols
+	for _, bp := range gr.breakpoints {
+		if bp.MinWidth <= width && bp.Cols > 0 {
+			cols = bp.Cols
+		}
+	}
+	if cols == gr.cols {
+		return false
+	}
+
+	gr.cols = cols
+	gr.table.Clear()
+	for i := range gr.comps {
+		gr.place(i)
+	}
+	return true
+}
+
+// widthBox is the hidden text box a Grid receives the viewport width in. Its script sends the width when the window is
+// loaded and, debounced, when it's resized.
+type widthBox struct {
+	gwu.TextBox
+}
+
+func (wb *widthBox) Render(w gwu.Writer) {
+	wb.TextBox.Render(w)
+	w.Writes(fmt.Sprintf(`<script>(function(){var t;function f(){var e=document.getElementById('%d');`+
+		`if(e&&e.value!=window.innerWidth){e.value=window.innerWidth;se(null,%d,%d,e.value);}}`+
+		`window.addEventListener('resize',function(){clearTimeout(t);t=setTimeout(f,200);});`+
+		`window.addEventListener('load',f);})();</script>`, int(wb.ID()), int(gwu.ETypeChange), int(wb.ID())))
+}
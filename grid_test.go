@@ -0,0 +1,69 @@
+package wgowut
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeGrid(t *testing.T) {
+	g := &GuiBuilder{}
+	gr := g.MakeGrid(2, Options{Rows: 3, Cols: 3, CellPadding: 4})
+	assert.Equal(t, 4, gr.Table().(gwu.TableView).CellPadding())
+	assert.Equal(t, 2, gr.Cols())
+
+	var labels []gwu.Comp
+	for i := 0; i < 5; i++ {
+		label := g.MakeLabel(strconv.Itoa(i), Options{})
+		labels = append(labels, label)
+		gr.Add(label)
+	}
+
+	checkLayout := func(cols int) {
+		assert.Equal(t, cols, gr.Cols())
+		assert.Equal(t, (len(labels)+cols-1)/cols, tableRows(gr.Table()))
+		for i, label := range labels {
+			row, col := gr.Table().CompIdx(label)
+			assert.Equal(t, i/cols, row, "label %d", i)
+			assert.Equal(t, i%cols, col, "label %d", i)
+			assert.Equal(t, strconv.Itoa(100/cols)+"%", gr.Table().CellFmt(row, col).Style().Width())
+		}
+	}
+	checkLayout(2)
+	assert.False(t, gr.Resize(1200))
+
+	// the viewport width reported by the browser picks the columns
+	gr.SetBreakpoints(Breakpoint{MinWidth: 900, Cols: 3}, Breakpoint{MinWidth: 0, Cols: 1}, Breakpoint{MinWidth: 600})
+	gr.SetBreakpoints(Breakpoint{MinWidth: 900, Cols: 3}, Breakpoint{MinWidth: 0, Cols: 1})
+	assert.Equal(t, 2, gr.CompsCount())
+
+	for _, tt := range []struct {
+		width string
+		cols  int
+		dirty bool
+	}{
+		{"1200", 3, true},
+		{"900", 3, false},
+		{"899", 1, true},
+		{"invalid", 1, false},
+	} {
+		gr.width.SetText(tt.width)
+		e := &testEvent{}
+		gr.widthHandler(e)
+		checkLayout(tt.cols)
+		if tt.dirty {
+			assert.Equal(t, []gwu.Comp{gr}, e.dirty, tt.width)
+		} else {
+			assert.Empty(t, e.dirty, tt.width)
+		}
+	}
+
+	var buf bytes.Buffer
+	gr.width.Render(gwu.NewWriter(&buf))
+	assert.Contains(t, buf.String(), fmt.Sprintf("se(null,%d,%d,e.value)", gwu.ETypeChange, gr.width.ID()))
+	assert.Contains(t, buf.String(), "display:none")
+}
@@ -0,0 +1,128 @@
+package wgowut
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Palette is a color gradient given by its stops, named gwu colors or #rgb / #rrggbb hex colors, from the color of the
+// lowest value to the color of the highest.
+type Palette []string
+
+// Palettes for ApplyHeatmap.
+var (
+	PaletteGreenRed = Palette{"#63be7b", "#ffeb84", "#f8696b"} // PaletteGreenRed goes from green over yellow to red, for values where high is bad.
+	PaletteRedGreen = Palette{"#f8696b", "#ffeb84", "#63be7b"} // PaletteRedGreen goes from red over yellow to green, for values where high is good.
+	PaletteBlues    = Palette{"#f7fbff", "#6baed6", "#08519c"} // PaletteBlues goes from white to dark blue.
+)
+
+// heatmapRange is the range of the values of a heatmap column.
+type heatmapRange struct {
+	min, max float64
+}
+
+// ApplyHeatmap colors the cell backgrounds of the numeric DataTable column along palette, from the lowest value of the
+// rows passing the filters (on all pages) to the highest, and re-renders the table. The range is computed again
+// whenever the table is rendered, for example after sorting, filtering or SetData. Number fields are used as is, and
+// strings (like CSV cells) if they can be parsed as a number; other cells are left uncolored. Cell rules (see
+// AddCellRule) are applied after the heatmap, and the text color is adjusted for the AccessibilityMode MinContrast. A
+// nil palette removes the heatmap of the column. Mark the table dirty after calling this from an event handler.
+func (g *GuiBuilder) ApplyHeatmap(dt *DataTable, col int, palette Palette) {
+	if palette == nil {
+		delete(dt.heatmaps, col)
+	} else {
+		if dt.heatmaps == nil {
+			dt.heatmaps = make(map[int]Palette)
+		}
+		dt.heatmaps[col] = palette
+	}
+	dt.render()
+}
+
+// heatmapRanges returns the ranges of the heatmap columns, leaving out columns without numeric values.
+func (dt *DataTable) heatmapRanges() map[int]heatmapRange {
+	ranges := make(map[int]heatmapRange, len(dt.heatmaps))
+	for col := range dt.heatmaps {
+		r := heatmapRange{min: math.Inf(1), max: math.Inf(-1)}
+		for _, record := range dt.records {
+			if value, ok := numericValue(dt.field(record, col)); ok {
+				r.min = math.Min(r.min, value)
+				r.max = math.Max(r.max, value)
+			}
+		}
+		if r.min <= r.max {
+			ranges[col] = r
+		}
+	}
+	return ranges
+}
+
+// heatmapColor returns the heatmap background of the data row and column, or "" if it has none.
+func (dt *DataTable) heatmapColor(ranges map[int]heatmapRange, row, col int) string {
+	r, ok := ranges[col]
+	if !ok {
+		return ""
+	}
+	value, ok := numericValue(dt.field(dt.records[row], col))
+	if !ok {
+		return ""
+	}
+
+	pos := 0.0
+	if r.max > r.min {
+		pos = (value - r.min) / (r.max - r.min)
+	}
+	return dt.heatmaps[col].color(pos)
+}
+
+// color returns the hex color at pos (from 0 to 1) of the gradient, or "" if the palette has no valid colors.
+func (p Palette) color(pos float64) string {
+	var stops [][3]uint8
+	for _, color := range p {
+		if rgb, ok := parseColor(color); ok {
+			stops = append(stops, rgb)
+		}
+	}
+	switch len(stops) {
+	case 0:
+		return ""
+	case 1:
+		return hexColor(stops[0])
+	}
+
+	pos = math.Max(0, math.Min(1, pos)) * float64(len(stops)-1)
+	i := int(pos)
+	if i == len(stops)-1 {
+		return hexColor(stops[i])
+	}
+	frac := pos - float64(i)
+	var rgb [3]uint8
+	for c := range rgb {
+		from, to := float64(stops[i][c]), float64(stops[i+1][c])
+		rgb[c] = uint8(math.Round(from + (to-from)*frac))
+	}
+	return hexColor(rgb)
+}
+
+func hexColor(rgb [3]uint8) string {
+	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
+}
+
+// numericValue returns the value of a number field, or of a string field holding a number, leaving out NaN.
+func numericValue(fv reflect.Value) (float64, bool) {
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(fv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(fv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return fv.Float(), !math.IsNaN(fv.Float())
+	case reflect.String:
+		value, err := strconv.ParseFloat(strings.TrimSpace(fv.String()), 64)
+		return value, err == nil && !math.IsNaN(value)
+	}
+	return 0, false
+}
@@ -0,0 +1,80 @@
+package wgowut
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPalette_color(t *testing.T) {
+	tests := []struct {
+		name    string
+		palette Palette
+		pos     float64
+		want    string
+	}{
+		{"start", Palette{"Black", "White"}, 0, "#000000"},
+		{"middle", Palette{"Black", "White"}, 0.5, "#808080"},
+		{"end", Palette{"Black", "White"}, 1, "#ffffff"},
+		{"clamped", Palette{"Black", "White"}, 2, "#ffffff"},
+		{"second segment", Palette{"#000", "Red", "#fff"}, 0.75, "#ff8080"},
+		{"single color", Palette{"Navy"}, 0.3, "#000080"},
+		{"invalid colors skipped", Palette{"nope", "#000", "#fff"}, 1, "#ffffff"},
+		{"no colors", Palette{"nope"}, 0.5, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.palette.color(tt.pos))
+		})
+	}
+}
+
+func TestGuiBuilder_ApplyHeatmap(t *testing.T) {
+	data := []testServer{
+		{Name: "alpha", Port: 80, Load: 0.5},
+		{Name: "beta", Port: 8080, Load: 1.5},
+		{Name: "gamma", Port: 443, Load: 1},
+		{Name: "delta", Port: 22, Load: math.NaN()},
+	}
+
+	g := &GuiBuilder{}
+	dt, err := g.MakeDataTable(data, Options{})
+	assert.NoError(t, err)
+
+	palette := Palette{"Black", "White"}
+	g.ApplyHeatmap(dt, 2, palette)
+	g.ApplyHeatmap(dt, 0, palette)
+	for row, want := range []string{"#000000", "#ffffff", "#808080", ""} {
+		assert.Equal(t, want, dt.CellFmt(row+1, 2).Style().Background())
+	}
+	assert.Equal(t, "", dt.CellFmt(1, 0).Style().Background())
+	assert.Equal(t, "", dt.CellFmt(1, 1).Style().Background())
+
+	// the range follows the filters
+	dt.SetFilter("ta")
+	assert.Equal(t, 2, dt.Len())
+	assert.Equal(t, "#000000", dt.CellFmt(1, 2).Style().Background())
+	assert.Equal(t, "", dt.CellFmt(2, 2).Style().Background())
+
+	// cell rules are applied after the heatmap
+	g.AddCellRule(dt, func(row, col int, value string) *Options {
+		return &Options{Background: "Red"}
+	})
+	assert.Equal(t, "Red", dt.CellFmt(1, 2).Style().Background())
+	dt.cellRules = nil
+
+	g.ApplyHeatmap(dt, 2, nil)
+	assert.Equal(t, "", dt.CellFmt(1, 2).Style().Background())
+
+	// CSV cells are parsed
+	csv, err := g.LoadTableFromCSV(strings.NewReader("host,ms\na,10\nb,x\nc,30\n"), Options{})
+	assert.NoError(t, err)
+	g.SetAccessibilityMode(AccessibilityOptions{MinContrast: 4.5})
+	g.ApplyHeatmap(csv, 1, palette)
+	assert.Equal(t, "#000000", csv.CellFmt(1, 1).Style().Background())
+	assert.Equal(t, "White", csv.CellFmt(1, 1).Style().Color())
+	assert.Equal(t, "", csv.CellFmt(2, 1).Style().Background())
+	assert.Equal(t, "#ffffff", csv.CellFmt(3, 1).Style().Background())
+}
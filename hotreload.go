@@ -0,0 +1,121 @@
+package wgowut
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// HotReloadInterval is the default interval at which HotReloadLayout checks the layout file for changes.
+const HotReloadInterval = time.Second
+
+// layoutReloader holds the state of a window loaded with HotReloadLayout.
+type layoutReloader struct {
+	g      *GuiBuilder
+	win    gwu.Window
+	path   string
+	onLoad func(root gwu.Comp, byID map[string]gwu.Comp)
+	timer  gwu.Timer
+	marker gwu.HTML // marker reloads the pages of the browsers showing an older version of the content
+
+	mu      sync.Mutex // mu guards the content of the window, as the timers of all its browsers check the file
+	modTime time.Time
+	version int // version is incremented on every change of the file
+}
+
+// HotReloadLayout is a development aid that fills win with the layout of the HTML file at path (see ParseHTMLLayout)
+// and reloads it whenever the file changes. A repeating timer added to the window checks the modification time of the
+// file every interval (HotReloadInterval if 0) while the window is open; on a change the window content is rebuilt and
+// every browser showing the window reloads it on its next check, as the window may be public, shared by the browsers. If the changed file can't be loaded, the error is displayed in the
+// window until the file is fixed. onLoad, if not nil, is called after every successful load so event handlers can be
+// attached to the components by id.
+//
+// An error is returned if the file can't be loaded initially.
+func (g *GuiBuilder) HotReloadLayout(win gwu.Window, path string, interval time.Duration, onLoad func(root gwu.Comp, byID map[string]gwu.Comp)) error {
+	_, err := g.newLayoutReloader(win, path, interval, onLoad)
+	return err
+}
+
+// newLayoutReloader implements HotReloadLayout, returning the reloader for tests.
+func (g *GuiBuilder) newLayoutReloader(win gwu.Window, path string, interval time.Duration, onLoad func(root gwu.Comp, byID map[string]gwu.Comp)) (*layoutReloader, error) {
+	if interval == 0 {
+		interval = HotReloadInterval
+	}
+	lr := &layoutReloader{
+		g:      g,
+		win:    win,
+		path:   path,
+		onLoad: onLoad,
+		timer:  gwu.NewTimer(interval),
+		marker: gwu.NewHTML(""),
+	}
+	lr.setMarker()
+	lr.timer.SetRepeat(true)
+	lr.timer.AddEHandlerFunc(lr.handleTimer, gwu.ETypeStateChange)
+
+	return lr, lr.load()
+}
+
+// load reads and parses the layout file and replaces the window content with it.
+func (lr *layoutReloader) load() error {
+	info, err := os.Stat(lr.path)
+	if err != nil {
+		return err
+	}
+	lr.modTime = info.ModTime()
+
+	f, err := os.Open(lr.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	root, byID, err := lr.g.ParseHTMLLayout(f)
+	if err != nil {
+		return err
+	}
+
+	lr.setContent(root)
+	if lr.onLoad != nil {
+		lr.onLoad(root, byID)
+	}
+	return nil
+}
+
+func (lr *layoutReloader) setContent(root gwu.Comp) {
+	for i := 0; i < lr.win.CompsCount(); i++ {
+		if child := lr.win.CompAt(i); child != lr.timer {
+			lr.g.ForgetTree(child)
+		}
+	}
+	lr.win.Clear()
+	lr.win.Add(root)
+	lr.win.Add(lr.timer)
+	lr.win.Add(lr.marker)
+}
+
+// setMarker sets the script of the marker: it records the version of the content a page was loaded with, and reloads
+// the page when re-rendered with another version.
+func (lr *layoutReloader) setMarker() {
+	lr.marker.SetHTML(fmt.Sprintf("<script>if(window.wgowutLayout!==undefined&&window.wgowutLayout!==%[1]d)"+
+		"location.reload();else window.wgowutLayout=%[1]d;</script>", lr.version))
+}
+
+// handleTimer reloads the content if the file changed, and re-renders the marker, so the browser of the event reloads
+// the window if it shows an older version.
+func (lr *layoutReloader) handleTimer(e gwu.Event) {
+	lr.mu.Lock()
+	if info, err := os.Stat(lr.path); err == nil && info.ModTime().After(lr.modTime) {
+		if err := lr.load(); err != nil {
+			lr.setContent(lr.g.MakeLabel("Could not reload "+lr.path+": "+err.Error(), Options{Color: gwu.ClrRed}))
+		}
+		lr.version++
+		lr.setMarker()
+	}
+	lr.mu.Unlock()
+
+	e.MarkDirty(lr.marker)
+}
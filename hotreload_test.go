@@ -0,0 +1,79 @@
+package wgowut
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_HotReloadLayout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wgowut")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "layout.html")
+
+	modTime := time.Now().Add(-time.Hour)
+	write := func(html string) {
+		assert.NoError(t, ioutil.WriteFile(path, []byte(html), 0644))
+		modTime = modTime.Add(time.Minute)
+		assert.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "Main", Options{})
+
+	assert.Error(t, g.HotReloadLayout(win, path, 0, nil))
+
+	write(`<button id="btn">One</button>`)
+	var loads []gwu.Comp
+	lr, err := g.newLayoutReloader(win, path, 0, func(root gwu.Comp, byID map[string]gwu.Comp) {
+		assert.Equal(t, root, byID["btn"])
+		loads = append(loads, root)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, HotReloadInterval, lr.timer.Timeout())
+	assert.True(t, lr.timer.Repeat())
+	assert.Equal(t, 3, win.CompsCount())
+	assert.Equal(t, "One", win.CompAt(0).(gwu.Button).Text())
+	assert.Equal(t, lr.timer, win.CompAt(1))
+	assert.Equal(t, lr.marker, win.CompAt(2))
+	assert.Equal(t, 1, len(loads))
+	assert.Contains(t, lr.marker.HTML(), "window.wgowutLayout!==0)location.reload();")
+
+	// every check re-renders the marker, which reloads the browsers showing another version
+	e := &testEvent{etype: gwu.ETypeStateChange}
+	lr.handleTimer(e)
+	assert.Equal(t, []gwu.Comp{lr.marker}, e.dirty)
+	assert.Equal(t, 1, len(loads))
+	assert.Contains(t, lr.marker.HTML(), "!==0)")
+
+	// changed file
+	write(`<button id="btn">Two</button>`)
+	lr.handleTimer(e)
+	assert.Equal(t, 3, win.CompsCount())
+	assert.Equal(t, "Two", win.CompAt(0).(gwu.Button).Text())
+	assert.Equal(t, 2, len(loads))
+	assert.Contains(t, lr.marker.HTML(), "window.wgowutLayout!==1)location.reload();else window.wgowutLayout=1;")
+
+	// broken file shows the error until fixed
+	write(`<blink>Three</blink>`)
+	lr.handleTimer(e)
+	assert.Contains(t, win.CompAt(0).(gwu.Label).Text(), "unsupported HTML element <blink>")
+	assert.Equal(t, lr.timer, win.CompAt(1))
+	assert.Equal(t, 2, len(loads))
+	assert.Contains(t, lr.marker.HTML(), "!==2)")
+
+	lr.handleTimer(e)
+	assert.Contains(t, lr.marker.HTML(), "!==2)")
+
+	write(`<button id="btn">Four</button>`)
+	lr.handleTimer(e)
+	assert.Equal(t, "Four", win.CompAt(0).(gwu.Button).Text())
+	assert.Equal(t, 3, len(loads))
+	assert.Contains(t, lr.marker.HTML(), "!==3)")
+}
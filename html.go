@@ -0,0 +1,416 @@
+package wgowut
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// htmlNode is a minimal element tree produced from the HTML input before it is converted to gwu components.
+type htmlNode struct {
+	name     string
+	attrs    map[string]string
+	text     string // text is only set for text nodes (name == "")
+	children []*htmlNode
+}
+
+// ParseHTMLLayout converts a constrained subset of HTML into gwu components so static pages can be made interactive.
+// The supported elements are table (with tr, th and td), div, span, input (text, password and checkbox), button and
+// select (with option). The html and body elements are walked through and head, script and style are skipped.
+// Inline style attributes are mapped into an Options struct and applied with the matching Make function, for example
+// width, height, color, background, font-size, white-space, border, padding, text-align and vertical-align; a width or
+// height of 100% is mapped to FullWidth or FullHeight. All the selected options of a multiple select are selected.
+//
+// A template element is replaced with an instance of the template named by its name attribute (see DefineTemplate),
+// its other attributes are passed as the params (with lower case names), for example: <template name="card" title="Servers"></template>.
+//
+// The text of a span element with a formatter attribute is formatted with the named formatter (see RegisterFormatter),
+// which receives an int64 or float64 if the text is a number, for example: <span formatter="bytes">1536</span>.
+//
+// The root component is returned along with a map of every element that had an id attribute so event handlers can be
+// attached to them. If the input has more than one top level element, they are added in order to a gwu.Panel.
+func (g *GuiBuilder) ParseHTMLLayout(r io.Reader) (gwu.Comp, map[string]gwu.Comp, error) {
+	roots, err := parseHTMLNodes(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[string]gwu.Comp)
+	comps, err := g.htmlNodesToComps(roots, byID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(comps) == 1 {
+		return comps[0], byID, nil
+	}
+
+	panel := g.MakePanel(Options{})
+	g.AddCompsToPanel(panel, comps...)
+
+	return panel, byID, nil
+}
+
+func parseHTMLNodes(r io.Reader) ([]*htmlNode, error) {
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	root := &htmlNode{}
+	stack := []*htmlNode{root}
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("wgowut: could not parse HTML: %v", err)
+		}
+
+		parent := stack[len(stack)-1]
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &htmlNode{name: strings.ToLower(t.Name.Local), attrs: make(map[string]string)}
+			for _, attr := range t.Attr {
+				node.attrs[strings.ToLower(attr.Name.Local)] = attr.Value
+			}
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			text := strings.TrimSpace(string(t))
+			if text != "" {
+				parent.children = append(parent.children, &htmlNode{text: text})
+			}
+		}
+	}
+
+	return root.children, nil
+}
+
+func (g *GuiBuilder) htmlNodesToComps(nodes []*htmlNode, byID map[string]gwu.Comp) ([]gwu.Comp, error) {
+	var comps []gwu.Comp
+	for _, node := range nodes {
+		switch node.name {
+		case "head", "script", "style", "title", "meta", "link":
+			continue
+		case "html", "body":
+			children, err := g.htmlNodesToComps(node.children, byID)
+			if err != nil {
+				return nil, err
+			}
+			comps = append(comps, children...)
+			continue
+		}
+
+		comp, err := g.htmlNodeToComp(node, byID)
+		if err != nil {
+			return nil, err
+		}
+		comps = append(comps, comp)
+	}
+	return comps, nil
+}
+
+func (g *GuiBuilder) htmlNodeToComp(node *htmlNode, byID map[string]gwu.Comp) (gwu.Comp, error) {
+	if node.name == "" {
+		return g.MakeLabel(node.text, Options{}), nil
+	}
+
+	options := parseStyle(node.attrs["style"])
+
+	var comp gwu.Comp
+	var err error
+
+	switch node.name {
+	case "table":
+		comp, err = g.htmlTable(node, options, byID)
+	case "div":
+		comp, err = g.htmlContainer(node, options, LayoutVertical, byID)
+	case "span":
+		if len(node.children) == 0 {
+			comp = g.MakeLabel("", options)
+		} else if len(node.children) == 1 && node.children[0].name == "" {
+			comp, err = g.htmlLabel(node, options)
+		} else {
+			comp, err = g.htmlContainer(node, options, LayoutHorizontal, byID)
+		}
+	case "input":
+		comp, err = g.htmlInput(node, options)
+	case "button":
+		comp = g.MakeButton(htmlText(node), options)
+	case "select":
+		comp = g.htmlSelect(node, options)
+	case "template":
+		comp, err = g.htmlTemplate(node)
+	default:
+		return nil, fmt.Errorf("wgowut: unsupported HTML element <%s>", node.name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if id := node.attrs["id"]; id != "" {
+		byID[id] = comp
+	}
+
+	return comp, nil
+}
+
+func (g *GuiBuilder) htmlContainer(node *htmlNode, options Options, layout Layout, byID map[string]gwu.Comp) (gwu.Comp, error) {
+	options.Layout = layout
+	panel := g.MakePanel(options)
+
+	comps, err := g.htmlNodesToComps(node.children, byID)
+	if err != nil {
+		return nil, err
+	}
+	g.AddCompsToPanel(panel, comps...)
+
+	return panel, nil
+}
+
+func (g *GuiBuilder) htmlTable(node *htmlNode, options Options, byID map[string]gwu.Comp) (gwu.Comp, error) {
+	var rows [][]*htmlNode
+	var walk func(nodes []*htmlNode)
+	walk = func(nodes []*htmlNode) {
+		for _, child := range nodes {
+			switch child.name {
+			case "thead", "tbody", "tfoot":
+				walk(child.children)
+			case "tr":
+				var cells []*htmlNode
+				for _, cell := range child.children {
+					if cell.name == "td" || cell.name == "th" {
+						cells = append(cells, cell)
+					}
+				}
+				rows = append(rows, cells)
+			}
+		}
+	}
+	walk(node.children)
+
+	cols := 0
+	for _, cells := range rows {
+		if len(cells) > cols {
+			cols = len(cells)
+		}
+	}
+
+	options.Rows, options.Cols = len(rows), cols
+	table := g.MakeTable(options)
+
+	for row, cells := range rows {
+		for col, cell := range cells {
+			comps, err := g.htmlNodesToComps(cell.children, byID)
+			if err != nil {
+				return nil, err
+			}
+
+			switch len(comps) {
+			case 0:
+			case 1:
+				table.Add(comps[0], row, col)
+			default:
+				panel := g.MakePanel(Options{Layout: LayoutHorizontal})
+				g.AddCompsToPanel(panel, comps...)
+				table.Add(panel, row, col)
+			}
+
+			cellOptions := parseStyle(cell.attrs["style"])
+			cellOptions.ColSpan = htmlIntAttr(cell, "colspan")
+			cellOptions.RowSpan = htmlIntAttr(cell, "rowspan")
+			g.FormatTableCell(table, row, col, cellOptions)
+		}
+	}
+
+	return table, nil
+}
+
+func (g *GuiBuilder) htmlInput(node *htmlNode, options Options) (gwu.Comp, error) {
+	if _, ok := node.attrs["disabled"]; ok {
+		options.Enable = EnableFalse
+	}
+	if _, ok := node.attrs["readonly"]; ok {
+		options.ReadOnly = true
+	}
+
+	switch strings.ToLower(node.attrs["type"]) {
+	case "", "text":
+		return g.MakeTextBox(node.attrs["value"], options), nil
+	case "password":
+		pb := gwu.NewPasswBox(node.attrs["value"])
+		setEnabled(pb, options.Enable)
+		pb.SetReadOnly(options.ReadOnly)
+		setStyle(pb.Style(), g.styleOptions(options))
+		g.enlargeTarget(pb)
+		return pb, nil
+	case "checkbox":
+		cb := gwu.NewCheckBox(node.attrs["value"])
+		_, checked := node.attrs["checked"]
+		cb.SetState(checked)
+		setEnabled(cb, options.Enable)
+		setStyle(cb.Style(), g.styleOptions(options))
+		g.enlargeTarget(cb)
+		return cb, nil
+	case "button", "submit":
+		return g.MakeButton(node.attrs["value"], options), nil
+	}
+
+	return nil, fmt.Errorf("wgowut: unsupported input type %q", node.attrs["type"])
+}
+
+// htmlLabel makes a label of the text of the node, formatted with the formatter named by its formatter attribute if set.
+func (g *GuiBuilder) htmlLabel(node *htmlNode, options Options) (gwu.Comp, error) {
+	text := node.children[0].text
+	name, ok := node.attrs["formatter"]
+	if !ok {
+		return g.MakeLabel(text, options), nil
+	}
+	fn := lookupFormatter(name)
+	if fn == nil {
+		return nil, fmt.Errorf("wgowut: unknown formatter %q", name)
+	}
+	return g.MakeLabel(fn(formatterValue(text)), options), nil
+}
+
+func (g *GuiBuilder) htmlTemplate(node *htmlNode) (gwu.Comp, error) {
+	params := make(map[string]string, len(node.attrs))
+	for name, value := range node.attrs {
+		if name != "name" && name != "id" {
+			params[name] = value
+		}
+	}
+	return g.Instantiate(node.attrs["name"], params)
+}
+
+func (g *GuiBuilder) htmlSelect(node *htmlNode, options Options) gwu.Comp {
+	_, options.Multi = node.attrs["multiple"]
+
+	var values []string
+	var selected []int // selected are the indices of the selected options, only the first one without multiple
+	for _, child := range node.children {
+		if child.name != "option" {
+			continue
+		}
+		if _, ok := child.attrs["selected"]; ok && (options.Multi || len(selected) == 0) {
+			selected = append(selected, len(values))
+		}
+		values = append(values, htmlText(child))
+	}
+
+	options.Rows = htmlIntAttr(node, "size")
+	if options.Rows == 0 {
+		options.Rows = 1
+	}
+	if _, ok := node.attrs["disabled"]; ok {
+		options.Enable = EnableFalse
+	}
+
+	lb := g.MakeListBox(values, options)
+	if len(selected) > 0 {
+		lb.SetSelectedIndices(selected)
+	}
+
+	return lb
+}
+
+// htmlText returns the concatenated text of the direct text children of the node.
+func htmlText(node *htmlNode) string {
+	var texts []string
+	for _, child := range node.children {
+		if child.name == "" {
+			texts = append(texts, child.text)
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
+func htmlIntAttr(node *htmlNode, name string) int {
+	value, err := strconv.Atoi(strings.TrimSpace(node.attrs[name]))
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// parseStyle maps the supported declarations of an inline style attribute into Options.
+func parseStyle(style string) Options {
+	var options Options
+	for _, decl := range strings.Split(style, ";") {
+		parts := strings.SplitN(decl, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		value := strings.TrimSpace(parts[1])
+
+		switch name {
+		case "width":
+			options.Width = fullOr(value, FullWidth)
+		case "height":
+			options.Height = fullOr(value, FullHeight)
+		case "color":
+			options.Color = value
+		case "background", "background-color":
+			options.Background = value
+		case "font-size":
+			options.FontSize = value
+		case "white-space":
+			options.WhiteSpace = value
+		case "padding":
+			options.CellPadding = cssPixels(value)
+		case "text-align":
+			options.HAlign = gwu.HAlign(value)
+		case "vertical-align":
+			options.VAlign = gwu.VAlign(value)
+		case "border":
+			for _, field := range strings.Fields(value) {
+				if width := cssPixels(field); width != 0 {
+					options.BorderWidth = width
+				} else if isBorderStyle(field) {
+					options.BorderStyle = field
+				} else {
+					options.BorderColor = field
+				}
+			}
+		}
+	}
+	return options
+}
+
+func fullOr(value, full string) string {
+	if value == "100%" {
+		return full
+	}
+	return value
+}
+
+// cssPixels returns the integer value of a CSS length such as "5px", or 0 if it can't be parsed.
+func cssPixels(value string) int {
+	pixels, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(value), "px"))
+	if err != nil {
+		return 0
+	}
+	return pixels
+}
+
+func isBorderStyle(value string) bool {
+	switch value {
+	case gwu.BrdStyleSolid, gwu.BrdStyleDashed, gwu.BrdStyleDotted, gwu.BrdStyleDouble,
+		gwu.BrdStyleGroove, gwu.BrdStyleRidge, gwu.BrdStyleInset, gwu.BrdStyleOutset, "none", "hidden":
+		return true
+	}
+	return false
+}
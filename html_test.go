@@ -0,0 +1,115 @@
+package wgowut
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_ParseHTMLLayout(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		html    string
+		wantErr bool
+		check   func(t *testing.T, root gwu.Comp, byID map[string]gwu.Comp)
+	}{
+		{"table with inputs", `<html><head><title>legacy</title></head><body>
+			<table id="tbl" style="border: 2px dotted red; padding: 3px">
+				<tr><th colspan="2" style="text-align: center">Header</th></tr>
+				<tr><td>Name</td><td><input id="name" type="text" value="bob" readonly></td></tr>
+				<tr><td>Pass</td><td><input id="pass" type="password" disabled></td></tr>
+			</table></body></html>`, false,
+			func(t *testing.T, root gwu.Comp, byID map[string]gwu.Comp) {
+				table := root.(gwu.Table)
+				assert.Equal(t, byID["tbl"], root)
+				assert.Equal(t, 3, table.CellPadding())
+				checkStyle(t, table.Style(), Options{BorderWidth: 2, BorderStyle: gwu.BrdStyleDotted, BorderColor: "red"})
+
+				assert.Equal(t, "Header", table.CompAt(0, 0).(gwu.Label).Text())
+				assert.Equal(t, 2, table.ColSpan(0, 0))
+				assert.Equal(t, gwu.HAlign(gwu.HACenter), table.CellFmt(0, 0).HAlign())
+
+				name := byID["name"].(gwu.TextBox)
+				assert.Equal(t, name, table.CompAt(1, 1))
+				assert.Equal(t, "bob", name.Text())
+				assert.Equal(t, true, name.ReadOnly())
+
+				assert.Equal(t, false, byID["pass"].(gwu.TextBox).Enabled())
+			}},
+		{"div with span, button, and select", `<div id="root" style="background: aqua; width: 100%; height: 100%">
+				<span style="color: maroon">label text</span>
+				<button id="btn">Go</button>
+				<select id="sel" multiple size="3"><option selected>a</option><option>b</option><option selected>c</option></select>
+				<select id="single"><option>a</option><option selected>b</option><option selected>c</option></select>
+				<input id="cb" type="checkbox" checked value="check me">
+			</div>`, false,
+			func(t *testing.T, root gwu.Comp, byID map[string]gwu.Comp) {
+				panel := root.(gwu.Panel)
+				assert.Equal(t, gwu.LayoutVertical, panel.Layout())
+				checkStyle(t, panel.Style(), Options{Background: "aqua", Width: FullWidth, Height: FullHeight})
+				assert.Equal(t, "100%", panel.Style().Height())
+				assert.Equal(t, 5, panel.CompsCount())
+
+				label := panel.CompAt(0).(gwu.Label)
+				assert.Equal(t, "label text", label.Text())
+				assert.Equal(t, "maroon", label.Style().Color())
+
+				assert.Equal(t, "Go", byID["btn"].(gwu.Button).Text())
+
+				lb := byID["sel"].(gwu.ListBox)
+				assert.Equal(t, []string{"a", "b", "c"}, lb.Values())
+				assert.Equal(t, true, lb.Multi())
+				assert.Equal(t, 3, lb.Rows())
+				assert.Equal(t, []int{0, 2}, lb.SelectedIndices())
+				assert.Equal(t, []int{1}, byID["single"].(gwu.ListBox).SelectedIndices())
+
+				cb := byID["cb"].(gwu.CheckBox)
+				assert.Equal(t, true, cb.State())
+				assert.Equal(t, "check me", cb.Text())
+			}},
+		{"multiple top level elements", `<span>one</span><span>two</span>`, false,
+			func(t *testing.T, root gwu.Comp, byID map[string]gwu.Comp) {
+				panel := root.(gwu.Panel)
+				assert.Equal(t, 2, panel.CompsCount())
+				assert.Equal(t, "two", panel.CompAt(1).(gwu.Label).Text())
+			}},
+		{"unsupported element", `<div><img src="x.png"></div>`, true, nil},
+		{"unsupported input type", `<input type="file">`, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			root, byID, err := g.ParseHTMLLayout(strings.NewReader(tt.html))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			tt.check(t, root, byID)
+		})
+	}
+}
+
+func Test_parseStyle(t *testing.T) {
+	tests := []struct {
+		name  string
+		style string
+		want  Options
+	}{
+		{"all supported declarations", "width: 10px; height:20px; color: red; background-color: blue; font-size: 12px; " +
+			"white-space: nowrap; padding: 4px; text-align: right; vertical-align: bottom; border: 1px solid black",
+			Options{Width: "10px", Height: "20px", Color: "red", Background: "blue", FontSize: "12px", WhiteSpace: "nowrap",
+				CellPadding: 4, HAlign: gwu.HARight, VAlign: gwu.VABottom, BorderWidth: 1, BorderStyle: "solid", BorderColor: "black"}},
+		{"full width and unknown declarations", "width: 100%; margin: 3px; junk", Options{Width: FullWidth}},
+		{"full height", "height: 100%", Options{Height: FullHeight}},
+		{"empty style", "", Options{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseStyle(tt.style))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package wgowut
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// jsonColors are the colors of JSON values in JSON viewers by their first byte.
+var jsonColors = map[byte]string{
+	'"': gwu.ClrGreen,
+	'n': gwu.ClrPurple, // null
+	't': gwu.ClrPurple, // true
+	'f': gwu.ClrPurple, // false
+}
+
+// jsonNumberColor is the color of JSON numbers in JSON viewers.
+const jsonNumberColor = gwu.ClrBlue
+
+// JSONViewerIndent is the indentation of nested values in JSON viewers made by MakeJSONViewer.
+const JSONViewerIndent = "16px"
+
+// jsonNode is a value of a JSON document: a scalar, or an object or array with its children.
+type jsonNode struct {
+	key      string      // key is the key of the value in its object, or its index in its array
+	value    string      // value is the JSON text of scalars
+	delim    json.Delim  // delim is '{' for objects, '[' for arrays and 0 for scalars
+	children []*jsonNode // children are the members of objects and the elements of arrays
+}
+
+// MakeJSONViewer creates a vertical panel displaying v as indented JSON, for debug pages. v is marshaled with
+// json.Marshal, so json.RawMessage values are displayed as they are, and the fields of structs keep their order.
+// Objects and arrays are expanders, expanded initially, with a header telling the number of their members, so each can
+// be collapsed on its own. Strings, numbers and other scalars are colored by their type. If v can't be marshaled, the
+// error is displayed in red instead.
+//
+// The options are used like in MakePanel (except Layout); the text is monospace.
+func (g *GuiBuilder) MakeJSONViewer(v interface{}, options Options) gwu.Panel {
+	options.Layout = LayoutVertical
+	panel := g.MakePanel(options)
+	panel.Style().Set("font-family", "monospace")
+
+	root, err := parseJSON(v)
+	if err != nil {
+		panel.Add(g.MakeLabel(err.Error(), Options{Color: gwu.ClrRed}))
+		return panel
+	}
+	panel.Add(g.makeJSONComp(root))
+	return panel
+}
+
+// parseJSON returns the JSON document of v.
+func parseJSON(v interface{}) (*jsonNode, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return parseJSONValue(dec)
+}
+
+// parseJSONValue parses the next value of dec.
+func parseJSONValue(dec *json.Decoder) (*jsonNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &jsonNode{}
+	switch tok := tok.(type) {
+	case json.Delim:
+		node.delim = tok
+		for i := 0; dec.More(); i++ {
+			key := strconv.Itoa(i)
+			if tok == '{' {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				key = keyTok.(string)
+			}
+			child, err := parseJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			child.key = key
+			node.children = append(node.children, child)
+		}
+		if _, err := dec.Token(); err != nil { // the closing delimiter
+			return nil, err
+		}
+	case nil:
+		node.value = "null"
+	default:
+		data, err := json.Marshal(tok)
+		if err != nil {
+			return nil, err
+		}
+		node.value = string(data)
+	}
+	return node, nil
+}
+
+// makeJSONComp returns the component displaying node: a label for scalars, an expander for objects and arrays.
+func (g *GuiBuilder) makeJSONComp(node *jsonNode) gwu.Comp {
+	prefix := ""
+	if node.key != "" {
+		prefix = node.key + ": "
+	}
+
+	if node.delim == 0 {
+		color, ok := jsonColors[node.value[0]]
+		if !ok {
+			color = jsonNumberColor
+		}
+		key := g.MakeLabel(prefix, Options{WhiteSpace: gwu.WhiteSpacePre})
+		value := g.MakeLabel(node.value, Options{Color: color, WhiteSpace: gwu.WhiteSpacePreWrap})
+		row := g.MakePanel(Options{Layout: LayoutHorizontal})
+		g.AddCompsToPanel(row, key, value)
+		return row
+	}
+
+	header := fmt.Sprintf("%s{%d}", prefix, len(node.children))
+	if node.delim == '[' {
+		header = fmt.Sprintf("%s[%d]", prefix, len(node.children))
+	}
+	content := g.MakePanel(Options{Layout: LayoutVertical})
+	content.Style().SetPaddingLeft(JSONViewerIndent)
+	for _, child := range node.children {
+		content.Add(g.makeJSONComp(child))
+	}
+
+	exp := gwu.NewExpander()
+	exp.SetHeader(g.MakeLabel(header, Options{}))
+	exp.SetContent(content)
+	exp.SetExpanded(true)
+	return exp
+}
@@ -0,0 +1,90 @@
+package wgowut
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+// jsonRow returns the texts of the key and value labels of a scalar displayed by a JSON viewer.
+func jsonRow(t *testing.T, comp gwu.Comp) (key, value gwu.Label) {
+	row, ok := comp.(gwu.Panel)
+	if assert.True(t, ok) && assert.Equal(t, 2, row.CompsCount()) {
+		return row.CompAt(0).(gwu.Label), row.CompAt(1).(gwu.Label)
+	}
+	return gwu.NewLabel(""), gwu.NewLabel("")
+}
+
+func TestGuiBuilder_MakeJSONViewer(t *testing.T) {
+	type host struct {
+		Name  string
+		Port  int
+		Up    bool
+		Tags  []string
+		Extra map[string]interface{}
+	}
+	g := &GuiBuilder{}
+	v := host{Name: "web-01", Port: 8080, Up: true, Tags: []string{"a", "b"}, Extra: map[string]interface{}{"z": nil, "load": 0.5}}
+	panel := g.MakeJSONViewer(v, Options{CellPadding: 2, Background: gwu.ClrWhite})
+
+	assert.Equal(t, gwu.LayoutVertical, panel.Layout())
+	assert.Equal(t, 2, panel.CellPadding())
+	assert.Equal(t, gwu.ClrWhite, panel.Style().Background())
+	assert.Equal(t, "monospace", panel.Style().Get("font-family"))
+	assert.Equal(t, 1, panel.CompsCount())
+
+	root := panel.CompAt(0).(gwu.Expander)
+	assert.True(t, root.Expanded())
+	assert.Equal(t, "{5}", root.Header().(gwu.Label).Text())
+	members := root.Content().(gwu.Panel)
+	assert.Equal(t, JSONViewerIndent, members.Style().PaddingLeft())
+	assert.Equal(t, 5, members.CompsCount())
+
+	tests := []struct {
+		i          int
+		key, value string
+		color      string
+	}{
+		{0, "Name: ", `"web-01"`, gwu.ClrGreen},
+		{1, "Port: ", "8080", gwu.ClrBlue},
+		{2, "Up: ", "true", gwu.ClrPurple},
+	}
+	for _, tt := range tests {
+		key, value := jsonRow(t, members.CompAt(tt.i))
+		assert.Equal(t, tt.key, key.Text())
+		assert.Equal(t, tt.value, value.Text())
+		assert.Equal(t, tt.color, value.Style().Color())
+	}
+
+	tags := members.CompAt(3).(gwu.Expander)
+	assert.Equal(t, "Tags: [2]", tags.Header().(gwu.Label).Text())
+	key, value := jsonRow(t, tags.Content().(gwu.Panel).CompAt(1))
+	assert.Equal(t, "1: ", key.Text())
+	assert.Equal(t, `"b"`, value.Text())
+
+	extra := members.CompAt(4).(gwu.Expander)
+	assert.Equal(t, "Extra: {2}", extra.Header().(gwu.Label).Text())
+	key, value = jsonRow(t, extra.Content().(gwu.Panel).CompAt(1)) // map keys are sorted
+	assert.Equal(t, "z: ", key.Text())
+	assert.Equal(t, "null", value.Text())
+}
+
+func TestGuiBuilder_MakeJSONViewer_raw(t *testing.T) {
+	g := &GuiBuilder{}
+
+	panel := g.MakeJSONViewer(json.RawMessage(`[1.50, "x"]`), Options{})
+	root := panel.CompAt(0).(gwu.Expander)
+	assert.Equal(t, "[2]", root.Header().(gwu.Label).Text())
+	_, value := jsonRow(t, root.Content().(gwu.Panel).CompAt(0))
+	assert.Equal(t, "1.50", value.Text())
+
+	_, value = jsonRow(t, g.MakeJSONViewer("plain", Options{}).CompAt(0))
+	assert.Equal(t, `"plain"`, value.Text())
+
+	panel = g.MakeJSONViewer(make(chan int), Options{})
+	label := panel.CompAt(0).(gwu.Label)
+	assert.Contains(t, label.Text(), "unsupported type")
+	assert.Equal(t, gwu.ClrRed, label.Style().Color())
+}
@@ -0,0 +1,70 @@
+package wgowut
+
+import (
+	"sort"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// KV is a key and its value displayed by MakeKeyValueTable.
+type KV struct {
+	Key   string
+	Value string
+}
+
+// KVsFromMap returns the entries of m as pairs sorted by key, so maps are displayed in a stable order.
+func KVsFromMap(m map[string]string) []KV {
+	pairs := make([]KV, 0, len(m))
+	for key, value := range m {
+		pairs = append(pairs, KV{key, value})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Key < pairs[j].Key })
+	return pairs
+}
+
+// MakeKeyValueTable creates a two-column table with a row for each pair, displaying the key in the first column and
+// the value in the second, like config or status listings. Keys are labels made with keyOptions and values labels made
+// with valueOptions; the CellPadding, HAlign, VAlign and Width of the options format the cells of their column, so
+// keys and values line up. Cells are aligned to the top unless VAlign is set, so keys stay next to the first line of
+// multi-line values.
+func (g *GuiBuilder) MakeKeyValueTable(pairs []KV, keyOptions, valueOptions Options) gwu.Table {
+	table := g.MakeTable(Options{})
+
+	for row, pair := range pairs {
+		table.Add(g.MakeLabel(pair.Key, keyOptions), row, 0)
+		table.Add(g.MakeLabel(pair.Value, valueOptions), row, 1)
+		g.FormatTableCell(table, row, 0, keyValueCellOptions(keyOptions))
+		g.FormatTableCell(table, row, 1, keyValueCellOptions(valueOptions))
+	}
+
+	return table
+}
+
+// keyValueCellOptions returns the options formatting the cells of a key value table column.
+func keyValueCellOptions(options Options) Options {
+	cellOptions := Options{CellPadding: options.CellPadding, HAlign: options.HAlign, VAlign: options.VAlign, Width: options.Width}
+	if cellOptions.VAlign == "" {
+		cellOptions.VAlign = gwu.VATop
+	}
+	return cellOptions
+}
+
+// MakeKeyValuePanel creates a vertical panel with a "Key: Value" row for each pair, for read-only detail views. The key
+// labels are made with MakeLabel using options, so keys can be styled with Bold, Color and the like; a Width is set on
+// the cells of the keys, giving them a fixed width so the values line up. The CellPadding of options is used for the
+// rows.
+func (g *GuiBuilder) MakeKeyValuePanel(data []KV, options Options) gwu.Panel {
+	panel := g.MakePanel(Options{Layout: LayoutVertical, CellPadding: options.CellPadding})
+
+	for _, pair := range data {
+		row := g.MakePanel(Options{Layout: LayoutHorizontal, CellPadding: options.CellPadding})
+		key := g.MakeLabel(pair.Key+":", options)
+		g.AddCompsToPanel(row, key, g.MakeLabel(pair.Value, Options{}))
+		if options.Width != "" {
+			row.CellFmt(key).Style().SetWidth(options.Width)
+		}
+		panel.Add(row)
+	}
+
+	return panel
+}
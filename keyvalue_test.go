@@ -0,0 +1,68 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKVsFromMap(t *testing.T) {
+	assert.Equal(t, []KV{{"host", "localhost"}, {"port", "8080"}, {"user", "admin"}},
+		KVsFromMap(map[string]string{"user": "admin", "host": "localhost", "port": "8080"}))
+	assert.Empty(t, KVsFromMap(nil))
+}
+
+func TestGuiBuilder_MakeKeyValueTable(t *testing.T) {
+	g := &GuiBuilder{}
+	keyOptions := Options{HAlign: gwu.HARight, Color: gwu.ClrGray, Width: "120px"}
+	valueOptions := Options{CellPadding: 2, VAlign: gwu.VAMiddle}
+	table := g.MakeKeyValueTable([]KV{{"Status", "running"}, {"Uptime", "3 days"}}, keyOptions, valueOptions)
+
+	assert.Equal(t, 2, tableRows(table))
+	for row, want := range [][]string{{"Status", "running"}, {"Uptime", "3 days"}} {
+		assert.Equal(t, 2, tableCols(table, row))
+		key, value := table.CompAt(row, 0).(gwu.Label), table.CompAt(row, 1).(gwu.Label)
+		assert.Equal(t, want[0], key.Text())
+		assert.Equal(t, want[1], value.Text())
+		assert.Equal(t, gwu.ClrGray, key.Style().Color())
+
+		assert.Equal(t, gwu.HAlign(gwu.HARight), table.CellFmt(row, 0).HAlign())
+		assert.Equal(t, gwu.VAlign(gwu.VATop), table.CellFmt(row, 0).VAlign())
+		assert.Equal(t, "120px", table.CellFmt(row, 0).Style().Width())
+		assert.Equal(t, gwu.VAlign(gwu.VAMiddle), table.CellFmt(row, 1).VAlign())
+		assert.Equal(t, "2", table.CellFmt(row, 1).Style().Padding())
+	}
+
+	assert.Equal(t, 0, tableRows(g.MakeKeyValueTable(nil, Options{}, Options{})))
+}
+
+func TestGuiBuilder_MakeKeyValuePanel(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+	}{
+		{"bold fixed width keys", Options{Bold: true, Width: "150px", CellPadding: 2}},
+		{"no options", Options{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			panel := g.MakeKeyValuePanel([]KV{{"Name", "web-01"}, {"State", "up"}}, tt.options)
+
+			assert.Equal(t, gwu.LayoutVertical, panel.Layout())
+			assert.Equal(t, 2, panel.CompsCount())
+			for i, want := range [][2]string{{"Name:", "web-01"}, {"State:", "up"}} {
+				row := panel.CompAt(i).(gwu.Panel)
+				assert.Equal(t, gwu.LayoutHorizontal, row.Layout())
+				assert.Equal(t, tt.options.CellPadding, row.CellPadding())
+				key, value := row.CompAt(0).(gwu.Label), row.CompAt(1).(gwu.Label)
+				assert.Equal(t, want[0], key.Text())
+				assert.Equal(t, want[1], value.Text())
+				assert.Equal(t, tt.options.Width, row.CellFmt(key).Style().Width())
+				assert.Equal(t, tt.options.Bold, key.Style().FontWeight() == gwu.FontWeightBold)
+				assert.Equal(t, "", value.Style().FontWeight())
+			}
+		})
+	}
+}
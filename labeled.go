@@ -0,0 +1,37 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// MakeLabeledTextBox creates a text box with MakeTextBox using tbOpts and a label for it with MakeLabel using
+// labelOpts, associated with LabelFor, and returns the panel holding them along with the text box. The label is next
+// to the text box, or above it if the Layout of labelOpts is LayoutVertical; the CellPadding of labelOpts is used for
+// the panel.
+func (g *GuiBuilder) MakeLabeledTextBox(label, text string, labelOpts, tbOpts Options) (gwu.Panel, gwu.TextBox) {
+	tb := g.MakeTextBox(text, tbOpts)
+	return g.makeLabeled(label, tb, labelOpts), tb
+}
+
+// MakeLabeledListBox creates a list box with MakeListBox using lbOpts and a label for it with MakeLabel using labelOpts,
+// laid out like MakeLabeledTextBox, and returns the panel holding them along with the list box.
+func (g *GuiBuilder) MakeLabeledListBox(label string, values []string, labelOpts, lbOpts Options) (gwu.Panel, gwu.ListBox) {
+	lb := g.MakeListBox(values, lbOpts)
+	return g.makeLabeled(label, lb, labelOpts), lb
+}
+
+// makeLabeled returns a panel holding input and its label made with labelOpts, laid out as described for
+// MakeLabeledTextBox.
+func (g *GuiBuilder) makeLabeled(text string, input gwu.Comp, labelOpts Options) gwu.Panel {
+	layout := labelOpts.Layout
+	if layout != LayoutVertical {
+		layout = LayoutHorizontal
+	}
+	panel := g.MakePanel(Options{Layout: layout, CellPadding: labelOpts.CellPadding, VAlign: gwu.VAMiddle})
+
+	label := g.MakeLabel(text, labelOpts)
+	g.LabelFor(label, input)
+	g.AddCompsToPanel(panel, label, input)
+
+	return panel
+}
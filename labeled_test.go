@@ -0,0 +1,55 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeLabeledTextBox(t *testing.T) {
+	tests := []struct {
+		name      string
+		labelOpts Options
+		layout    gwu.Layout
+	}{
+		{"default layout", Options{Color: gwu.ClrGray}, gwu.LayoutHorizontal},
+		{"stacked", Options{Layout: LayoutVertical, CellPadding: 2}, gwu.LayoutVertical},
+		{"natural layout is horizontal", Options{Layout: LayoutNatural}, gwu.LayoutHorizontal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			panel, tb := g.MakeLabeledTextBox("Name", "bob", tt.labelOpts, Options{Width: "200px"})
+
+			assert.Equal(t, tt.layout, panel.Layout())
+			assert.Equal(t, tt.labelOpts.CellPadding, panel.CellPadding())
+			assert.Equal(t, 2, panel.CompsCount())
+
+			label := panel.CompAt(0).(gwu.Label)
+			assert.Equal(t, "Name", label.Text())
+			assert.Equal(t, tt.labelOpts.Color, label.Style().Color())
+			assert.Equal(t, gwu.Comp(tb), panel.CompAt(1))
+			assert.Equal(t, "bob", tb.Text())
+			assert.Equal(t, "200px", tb.Style().Width())
+			assert.Equal(t, label.ID().String(), tb.Attr("aria-labelledby"))
+			assert.Equal(t, "Name", tb.Attr("aria-label"))
+		})
+	}
+}
+
+func TestGuiBuilder_MakeLabeledListBox(t *testing.T) {
+	g := &GuiBuilder{}
+	panel, lb := g.MakeLabeledListBox("Role", []string{"admin", "user"}, Options{Layout: LayoutVertical}, Options{Rows: 2})
+
+	assert.Equal(t, gwu.LayoutVertical, panel.Layout())
+	assert.Equal(t, 2, panel.CompsCount())
+	label := panel.CompAt(0).(gwu.Label)
+	assert.Equal(t, "Role", label.Text())
+	assert.Equal(t, gwu.Comp(lb), panel.CompAt(1))
+	assert.Equal(t, []string{"admin", "user"}, lb.Values())
+	assert.Equal(t, 2, lb.Rows())
+	assert.Equal(t, "admin", lb.SelectedValue())
+	assert.Equal(t, label.ID().String(), lb.Attr("aria-labelledby"))
+	assert.Equal(t, "Role", lb.Attr("aria-label"))
+}
@@ -0,0 +1,124 @@
+package wgowut
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// LiveRefreshInterval is the default interval of the timers made by LiveRegistry.NewTimer.
+const LiveRefreshInterval = time.Second
+
+// LiveRegistry re-renders registered components on demand. Components are registered by name with a render function,
+// and Invalidate, which may be called from any goroutine, schedules the component for a rebuild. Since gowut only
+// updates the browser in response to events, the rebuild happens on the next Flush, called by the timer of NewTimer or
+// from any event handler. The components may be shared by sessions, like those of public windows: they are rebuilt
+// once, and marked dirty by the next Flush of every session.
+type LiveRegistry struct {
+	g    *GuiBuilder
+	attr string // attr is the session attribute holding the generations of the entries flushed in the session
+
+	mu      sync.Mutex
+	entries map[string]*liveEntry
+	gen     int // gen is the generation of the last invalidation
+}
+
+// liveEntry is a component registered in a LiveRegistry.
+type liveEntry struct {
+	comp     gwu.Comp
+	render   func()
+	gen      int // gen is the generation the entry was last invalidated in, 0 if it wasn't
+	rendered int // rendered is the generation the entry was last rebuilt for
+}
+
+// NewLiveRegistry returns an empty LiveRegistry, running the OnRender functions of g on Flush.
+func (g *GuiBuilder) NewLiveRegistry() *LiveRegistry {
+	r := &LiveRegistry{g: g, entries: make(map[string]*liveEntry)}
+	r.attr = fmt.Sprintf("wgowut.LiveRegistry.%p", r)
+	return r
+}
+
+// Register registers comp by name, replacing a component already registered by the same name. render rebuilds comp
+// from the current data, and may be nil if comp updates itself when rendered.
+func (r *LiveRegistry) Register(name string, comp gwu.Comp, render func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries[name] = &liveEntry{comp: comp, render: render}
+}
+
+// Unregister removes the component registered by name, including a pending rebuild of it.
+func (r *LiveRegistry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.entries, name)
+}
+
+// Invalidate schedules the components registered by the names for a rebuild on the next Flush. Unknown names are
+// ignored. It is safe to call Invalidate from any goroutine.
+func (r *LiveRegistry) Invalidate(names ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.gen++
+	for _, name := range names {
+		if entry := r.entries[name]; entry != nil {
+			entry.gen = r.gen
+		}
+	}
+}
+
+// Flush rebuilds the components invalidated since they were last rebuilt by calling their render functions, and
+// marks the components invalidated since the last Flush of the session of e dirty, calling their OnRender functions
+// first. It reports if there were any such components.
+func (r *LiveRegistry) Flush(e gwu.Event) bool {
+	sess := e.Session()
+	flushed, _ := sess.Attr(r.attr).(map[string]int)
+	if flushed == nil {
+		flushed = make(map[string]int)
+		sess.SetAttr(r.attr, flushed)
+	}
+
+	r.mu.Lock()
+	var entries, renders []*liveEntry
+	for name, entry := range r.entries {
+		if entry.gen <= flushed[name] {
+			continue
+		}
+		flushed[name] = entry.gen
+		entries = append(entries, entry)
+		if entry.rendered < entry.gen {
+			entry.rendered = entry.gen
+			renders = append(renders, entry)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, entry := range renders {
+		if entry.render != nil {
+			entry.render()
+		}
+	}
+	for _, entry := range entries {
+		r.g.runRenderHooks(entry.comp)
+		e.MarkDirty(entry.comp)
+	}
+	return len(entries) > 0
+}
+
+// NewTimer returns a hidden repeating timer calling Flush every interval, LiveRefreshInterval if interval is 0. The
+// timer has to be added to the window of the registered components.
+func (r *LiveRegistry) NewTimer(interval time.Duration) gwu.Timer {
+	if interval == 0 {
+		interval = LiveRefreshInterval
+	}
+	timer := gwu.NewTimer(interval)
+	timer.SetRepeat(true)
+	timer.AddEHandlerFunc(func(e gwu.Event) {
+		r.Flush(e)
+	}, gwu.ETypeStateChange)
+	return timer
+}
@@ -0,0 +1,76 @@
+package wgowut
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveRegistry(t *testing.T) {
+	g := &GuiBuilder{}
+	cpu := g.MakeLabel("", Options{})
+	mem := g.MakeLabel("", Options{})
+
+	r := g.NewLiveRegistry()
+	renders := 0
+	r.Register("cpuTable", cpu, func() {
+		renders++
+		cpu.SetText("42%")
+	})
+	r.Register("mem", mem, nil)
+
+	sess := newTestSession("s1")
+	e := &testEvent{etype: gwu.ETypeStateChange, sess: sess}
+	assert.False(t, r.Flush(e))
+	assert.Empty(t, e.dirty)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.Invalidate("cpuTable", "unknown")
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, r.Flush(e))
+	assert.Equal(t, 1, renders)
+	assert.Equal(t, "42%", cpu.Text())
+	assert.Equal(t, []gwu.Comp{cpu}, e.dirty)
+
+	e = &testEvent{etype: gwu.ETypeStateChange, sess: sess}
+	assert.False(t, r.Flush(e))
+	assert.Empty(t, e.dirty)
+
+	// other sessions showing the components mark them dirty too, without rebuilding them again
+	other := &testEvent{etype: gwu.ETypeStateChange, sess: newTestSession("s2")}
+	assert.True(t, r.Flush(other))
+	assert.Equal(t, 1, renders)
+	assert.Equal(t, []gwu.Comp{cpu}, other.dirty)
+	other = &testEvent{etype: gwu.ETypeStateChange, sess: other.sess}
+	assert.False(t, r.Flush(other))
+
+	r.Invalidate("mem", "cpuTable")
+	r.Unregister("cpuTable")
+	assert.True(t, r.Flush(e))
+	assert.Equal(t, 1, renders)
+	assert.Equal(t, []gwu.Comp{mem}, e.dirty)
+	assert.True(t, r.Flush(other))
+	assert.Equal(t, []gwu.Comp{mem}, other.dirty)
+}
+
+func TestLiveRegistry_NewTimer(t *testing.T) {
+	g := &GuiBuilder{}
+	r := g.NewLiveRegistry()
+
+	timer := r.NewTimer(0)
+	assert.Equal(t, LiveRefreshInterval, timer.Timeout())
+	assert.True(t, timer.Repeat())
+	assert.Equal(t, 1, timer.HandlersCount(gwu.ETypeStateChange))
+
+	assert.Equal(t, 5*time.Second, r.NewTimer(5*time.Second).Timeout())
+}
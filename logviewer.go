@@ -0,0 +1,148 @@
+package wgowut
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// Log viewer defaults used by MakeLogViewer for the options left blank.
+const (
+	LogViewerMaxLines = 1000    // LogViewerMaxLines is the default number of lines kept by log viewers.
+	LogViewerHeight   = "300px" // LogViewerHeight is the default height of log viewers.
+)
+
+// LogViewer displays the last lines of a log in a scrolling monospace pane, showing the newest lines. Create it with
+// MakeLogViewer.
+type LogViewer struct {
+	gwu.Panel
+	view   gwu.HTML
+	timer  gwu.Timer
+	format func(line string) string // format returns the HTML displaying a line
+
+	mu      sync.Mutex
+	lines   []string // lines is a ring buffer of at most max lines, the oldest at start once full
+	start   int
+	max     int
+	changed bool // changed tells if lines changed since the view was updated
+}
+
+// MakeLogViewer creates an empty log viewer keeping the last MaxLines lines (LogViewerMaxLines by default). Lines can
+// be appended from any goroutine; the view is updated when rendered, so it's kept up to date by marking it dirty, or
+// by a timer set up with SetRefreshInterval. The view scrolls to the newest line when updated. The pane is styled with
+// options; Width defaults to FullWidth, Height to LogViewerHeight, and it gets a silver border unless a BorderWidth is
+// given. The Color, Background and FontSize options are also used.
+func (g *GuiBuilder) MakeLogViewer(options Options) *LogViewer {
+	lv := &LogViewer{Panel: g.MakePanel(Options{Layout: LayoutVertical}), view: gwu.NewHTML(""), format: html.EscapeString,
+		max: options.MaxLines}
+	if lv.max <= 0 {
+		lv.max = LogViewerMaxLines
+	}
+	if options.Width == "" {
+		options.Width = FullWidth
+	}
+	if options.Height == "" {
+		options.Height = LogViewerHeight
+	}
+	if options.BorderWidth == 0 {
+		options.BorderWidth, options.BorderStyle, options.BorderColor = 1, gwu.BrdStyleSolid, gwu.ClrSilver
+	}
+	setStyle(lv.view.Style(), g.styleOptions(options))
+	lv.view.Style().SetDisplay(gwu.DisplayBlock).Set("overflow", "auto").Set("font-family", "monospace")
+
+	lv.timer = gwu.NewTimer(time.Second)
+	lv.timer.SetRepeat(true)
+	lv.timer.SetActive(false)
+	lv.timer.AddEHandlerFunc(lv.refreshHandler, gwu.ETypeStateChange)
+	g.AddCompsToPanel(lv, lv.view, lv.timer)
+
+	lv.changed = true
+	return lv
+}
+
+// Append appends a line to the log viewer, dropping the oldest line if it's full. It's safe to call from any
+// goroutine; the line is displayed the next time the log viewer is rendered.
+func (lv *LogViewer) Append(line string) {
+	lv.mu.Lock()
+	defer lv.mu.Unlock()
+
+	if len(lv.lines) < lv.max {
+		lv.lines = append(lv.lines, line)
+	} else {
+		lv.lines[lv.start] = line
+		lv.start = (lv.start + 1) % lv.max
+	}
+	lv.changed = true
+}
+
+// Lines returns the lines kept by the log viewer, the oldest first.
+func (lv *LogViewer) Lines() []string {
+	lv.mu.Lock()
+	defer lv.mu.Unlock()
+
+	return lv.linesLocked()
+}
+
+// Clear removes all lines of the log viewer. It's safe to call from any goroutine.
+func (lv *LogViewer) Clear() {
+	lv.mu.Lock()
+	defer lv.mu.Unlock()
+
+	lv.lines, lv.start, lv.changed = nil, 0, true
+}
+
+// SetRefreshInterval makes a timer update the log viewer in the browser every interval while lines are appended, so
+// the newest lines stay visible without user interaction. The timer is stopped if interval is not positive. Mark the
+// log viewer dirty after calling this from an event handler.
+func (lv *LogViewer) SetRefreshInterval(interval time.Duration) {
+	if interval <= 0 {
+		lv.timer.SetActive(false)
+		return
+	}
+	lv.timer.SetTimeout(interval)
+	lv.timer.SetActive(true)
+}
+
+// Render updates the view of the log viewer with the appended lines, and renders it.
+func (lv *LogViewer) Render(w gwu.Writer) {
+	lv.update()
+	lv.Panel.Render(w)
+}
+
+// refreshHandler updates the view on ticks of the timer, and marks it dirty if lines were appended.
+func (lv *LogViewer) refreshHandler(e gwu.Event) {
+	if lv.update() {
+		e.MarkDirty(lv.view)
+	}
+}
+
+// update updates the view with the lines if they changed, and tells if they did.
+func (lv *LogViewer) update() bool {
+	lv.mu.Lock()
+	if !lv.changed {
+		lv.mu.Unlock()
+		return false
+	}
+	lines := lv.linesLocked()
+	lv.changed = false
+	lv.mu.Unlock()
+
+	formatted := make([]string, len(lines))
+	for i, line := range lines {
+		formatted[i] = lv.format(line)
+	}
+	lv.view.SetHTML(fmt.Sprintf(`<pre style="margin:0">%s</pre>`+
+		`<script>(function(){var e=document.getElementById("%s");if(e)e.scrollTop=e.scrollHeight;})();</script>`,
+		strings.Join(formatted, "\n"), lv.view.ID()))
+	return true
+}
+
+// linesLocked returns the lines in order; lv.mu must be held.
+func (lv *LogViewer) linesLocked() []string {
+	lines := make([]string, 0, len(lv.lines))
+	return append(append(lines, lv.lines[lv.start:]...), lv.lines[:lv.start]...)
+}
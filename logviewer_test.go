@@ -0,0 +1,75 @@
+package wgowut
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeLogViewer(t *testing.T) {
+	g := &GuiBuilder{}
+	lv := g.MakeLogViewer(Options{MaxLines: 3, Background: gwu.ClrBlack, Color: gwu.ClrWhite})
+
+	assert.Equal(t, 2, lv.CompsCount())
+	assert.Equal(t, "100%", lv.view.Style().Width())
+	assert.Equal(t, LogViewerHeight, lv.view.Style().Height())
+	assert.Equal(t, gwu.ClrBlack, lv.view.Style().Background())
+	assert.Equal(t, "monospace", lv.view.Style().Get("font-family"))
+	assert.Equal(t, "auto", lv.view.Style().Get("overflow"))
+	assert.Equal(t, "1px solid "+gwu.ClrSilver, lv.view.Style().Border())
+	assert.False(t, lv.timer.Active())
+	assert.Empty(t, lv.Lines())
+
+	// the oldest lines are dropped once full
+	for i := 1; i <= 4; i++ {
+		lv.Append(fmt.Sprint("line ", i))
+	}
+	assert.Equal(t, []string{"line 2", "line 3", "line 4"}, lv.Lines())
+	lv.Append("<b>5</b>")
+	assert.Equal(t, []string{"line 3", "line 4", "<b>5</b>"}, lv.Lines())
+
+	e := &testEvent{etype: gwu.ETypeStateChange, src: lv.timer}
+	lv.refreshHandler(e)
+	assert.Equal(t, []gwu.Comp{lv.view}, e.dirty)
+	assert.Contains(t, lv.view.HTML(), "<pre style=\"margin:0\">line 3\nline 4\n&lt;b&gt;5&lt;/b&gt;</pre>")
+	assert.Contains(t, lv.view.HTML(), `getElementById("`+lv.view.ID().String()+`")`)
+
+	// nothing is marked dirty without new lines
+	e = &testEvent{etype: gwu.ETypeStateChange, src: lv.timer}
+	lv.refreshHandler(e)
+	assert.Empty(t, e.dirty)
+
+	lv.Clear()
+	assert.Empty(t, lv.Lines())
+	assert.Contains(t, renderHTML(lv), `<pre style="margin:0"></pre>`)
+
+	lv.SetRefreshInterval(2 * time.Second)
+	assert.True(t, lv.timer.Active())
+	assert.Equal(t, 2*time.Second, lv.timer.Timeout())
+	lv.SetRefreshInterval(0)
+	assert.False(t, lv.timer.Active())
+}
+
+func TestLogViewer_Append_concurrent(t *testing.T) {
+	g := &GuiBuilder{}
+	lv := g.MakeLogViewer(Options{Height: "100px", BorderWidth: 2, BorderStyle: gwu.BrdStyleDashed, BorderColor: gwu.ClrGray})
+	assert.Equal(t, "100px", lv.view.Style().Height())
+	assert.Equal(t, "2px dashed "+gwu.ClrGray, lv.view.Style().Border())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				lv.Append("x")
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, lv.Lines(), LogViewerMaxLines)
+}
@@ -0,0 +1,114 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// Menu is a dropdown menu of a MenuBar, displaying its Items when its Title is clicked.
+type Menu struct {
+	Title string
+	Items []MenuItem
+}
+
+// MenuItem is an item of a Menu; OnClick is called when it's clicked, after the menu is closed.
+type MenuItem struct {
+	Text    string
+	OnClick func(gwu.Event)
+}
+
+// MenuBar is a horizontal bar of menu titles opening dropdown menus. Clicking a title opens or closes its menu, and
+// while a menu is open, hovering another title switches to its menu. Create it with MakeMenuBar.
+type MenuBar struct {
+	gwu.Panel
+	menus     []Menu
+	dropdowns []gwu.Panel
+	open      int
+}
+
+// MakeMenuBar creates a menu bar with menus, from left to right. The dropdowns are panels shown below their titles,
+// above the content of the window.
+func (g *GuiBuilder) MakeMenuBar(menus ...Menu) *MenuBar {
+	mb := &MenuBar{Panel: g.MakePanel(Options{Layout: LayoutHorizontal, Background: ToolbarBackground, Width: FullWidth}),
+		menus: menus, open: -1}
+	mb.Style().Set("border-bottom", "1px solid "+gwu.ClrSilver)
+
+	for i, menu := range menus {
+		wrapper := g.MakePanel(Options{Layout: LayoutVertical})
+		wrapper.Style().Set("position", "relative")
+
+		title := g.MakeLabel(menu.Title, Options{WhiteSpace: gwu.WhiteSpaceNowrap})
+		title.Style().SetPadding("4px 10px").Set("cursor", "pointer")
+		title.AddEHandlerFunc(mb.titleHandler(i), gwu.ETypeClick, gwu.ETypeMouseOver)
+
+		dropdown := g.MakePanel(Options{Layout: LayoutVertical, Background: gwu.ClrWhite, BorderWidth: 1,
+			BorderStyle: gwu.BrdStyleSolid, BorderColor: gwu.ClrSilver})
+		dropdown.Style().Set("position", "absolute").Set("top", "100%").Set("left", "0").Set("z-index", "100").
+			Set("min-width", "150px").Set("box-shadow", "0 2px 6px rgba(0,0,0,0.2)").SetDisplay(gwu.DisplayNone)
+		for j, menuItem := range menu.Items {
+			item := g.MakeLabel(menuItem.Text, Options{WhiteSpace: gwu.WhiteSpaceNowrap})
+			item.Style().SetPadding("4px 10px").Set("cursor", "pointer")
+			item.SetAttr("onmouseover", "this.style.background='#E8E8F0'")
+			item.SetAttr("onmouseout", "this.style.background=''")
+			item.AddEHandlerFunc(mb.itemHandler(i, j), gwu.ETypeClick)
+			dropdown.Add(item)
+		}
+
+		g.AddCompsToPanel(wrapper, title, dropdown)
+		mb.Add(wrapper)
+		mb.dropdowns = append(mb.dropdowns, dropdown)
+	}
+	mb.AddHConsumer()
+
+	return mb
+}
+
+// Open opens the menu of index i, closing the open one. Mark the menu bar dirty after calling this from an event
+// handler.
+func (mb *MenuBar) Open(i int) {
+	if i < 0 || i >= len(mb.dropdowns) {
+		return
+	}
+	mb.Close()
+	mb.dropdowns[i].Style().SetDisplay("")
+	mb.open = i
+}
+
+// Close closes the open menu, if any. Mark the menu bar dirty after calling this from an event handler.
+func (mb *MenuBar) Close() {
+	if mb.open >= 0 {
+		mb.dropdowns[mb.open].Style().SetDisplay(gwu.DisplayNone)
+	}
+	mb.open = -1
+}
+
+// OpenMenu returns the index of the open menu, or -1 if all menus are closed.
+func (mb *MenuBar) OpenMenu() int {
+	return mb.open
+}
+
+func (mb *MenuBar) titleHandler(i int) func(gwu.Event) {
+	return func(e gwu.Event) {
+		switch {
+		case e.Type() == gwu.ETypeMouseOver:
+			if mb.open < 0 || mb.open == i {
+				return
+			}
+			mb.Open(i)
+		case mb.open == i:
+			mb.Close()
+		default:
+			mb.Open(i)
+		}
+		e.MarkDirty(mb)
+	}
+}
+
+func (mb *MenuBar) itemHandler(i, j int) func(gwu.Event) {
+	return func(e gwu.Event) {
+		mb.Close()
+		e.MarkDirty(mb)
+		if fn := mb.menus[i].Items[j].OnClick; fn != nil {
+			fn(e)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeMenuBar(t *testing.T) {
+	g := &GuiBuilder{}
+	var clicked string
+	click := func(name string) func(gwu.Event) { return func(gwu.Event) { clicked = name } }
+	mb := g.MakeMenuBar(
+		Menu{"File", []MenuItem{{"Open", click("open")}, {"Save", click("save")}}},
+		Menu{"Help", []MenuItem{{"About", click("about")}, {"Disabled", nil}}},
+	)
+
+	assert.Equal(t, gwu.LayoutHorizontal, mb.Layout())
+	assert.Equal(t, 3, mb.CompsCount()) // 2 menus and the space consumer
+	for i, want := range []string{"File", "Help"} {
+		wrapper := mb.CompAt(i).(gwu.Panel)
+		assert.Equal(t, want, wrapper.CompAt(0).(gwu.Label).Text())
+		assert.Equal(t, gwu.DisplayNone, wrapper.CompAt(1).Style().Display())
+	}
+	assert.Equal(t, -1, mb.OpenMenu())
+
+	// hovering doesn't open menus while all are closed
+	e := &testEvent{etype: gwu.ETypeMouseOver}
+	mb.titleHandler(1)(e)
+	assert.Equal(t, -1, mb.OpenMenu())
+	assert.Nil(t, e.dirty)
+
+	e = &testEvent{etype: gwu.ETypeClick}
+	mb.titleHandler(0)(e)
+	assert.Equal(t, 0, mb.OpenMenu())
+	assert.Equal(t, "", mb.dropdowns[0].Style().Display())
+	assert.Equal(t, []gwu.Comp{mb}, e.dirty)
+
+	// hovering switches the open menu
+	mb.titleHandler(1)(&testEvent{etype: gwu.ETypeMouseOver})
+	assert.Equal(t, 1, mb.OpenMenu())
+	assert.Equal(t, gwu.DisplayNone, mb.dropdowns[0].Style().Display())
+	assert.Equal(t, "", mb.dropdowns[1].Style().Display())
+
+	// clicking the title of the open menu closes it
+	mb.titleHandler(1)(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, -1, mb.OpenMenu())
+
+	mb.Open(0)
+	mb.itemHandler(0, 1)(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, "save", clicked)
+	assert.Equal(t, -1, mb.OpenMenu())
+	assert.Equal(t, gwu.DisplayNone, mb.dropdowns[0].Style().Display())
+
+	mb.Open(1)
+	mb.itemHandler(1, 1)(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, -1, mb.OpenMenu())
+
+	mb.Open(5)
+	assert.Equal(t, -1, mb.OpenMenu())
+}
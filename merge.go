@@ -0,0 +1,119 @@
+package wgowut
+
+import (
+	"fmt"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// cellRange is a rectangle of table cells, inclusive.
+type cellRange struct {
+	fromRow, fromCol, toRow, toCol int
+}
+
+func (r cellRange) overlaps(o cellRange) bool {
+	return r.fromRow <= o.toRow && o.fromRow <= r.toRow && r.fromCol <= o.toCol && o.fromCol <= r.toCol
+}
+
+func (r cellRange) String() string {
+	return fmt.Sprintf("(%d, %d) to (%d, %d)", r.fromRow, r.fromCol, r.toRow, r.toCol)
+}
+
+// MergeCells merges the cells of table from fromRow, fromCol to toRow, toCol (inclusive) into one: the top left cell
+// gets the RowSpan and ColSpan covering the range, and the components of the other cells are removed (and forgotten,
+// see ForgetTree) and their cells hidden, so the rows keep their column positions. The table grows to hold the range
+// if needed. An error is returned for an invalid range, or one overlapping cells that already span other cells, by
+// MergeCells or FormatTableCell.
+//
+// FormatTableCell sets the spans of a cell, so format the top left cell before merging. Merges are moved by
+// RemoveTableRow, and kept by CloneTree for tables made by g after EnableCloning.
+func (g *GuiBuilder) MergeCells(table gwu.Table, fromRow, fromCol, toRow, toCol int) error {
+	m := cellRange{fromRow, fromCol, toRow, toCol}
+	if fromRow < 0 || fromCol < 0 || toRow < fromRow || toCol < fromCol {
+		return fmt.Errorf("wgowut: invalid cell range %v", m)
+	}
+	if other, ok := spanningOverlap(table, m); ok {
+		return fmt.Errorf("wgowut: cell range %v overlaps the spanning cells %v", m, other)
+	}
+
+	for row := fromRow; row <= toRow; row++ {
+		table.EnsureCols(row, toCol+1)
+	}
+	g.applyMerge(table, m)
+
+	g.mu.Lock()
+	r := g.tableRecipe(table, true)
+	r.merges = append(r.merges, m)
+	g.mu.Unlock()
+
+	return nil
+}
+
+// spanningOverlap returns the range of the first cell of table spanning more than itself that overlaps m.
+func spanningOverlap(table gwu.Table, m cellRange) (cellRange, bool) {
+	for row, rows := 0, tableRows(table); row < rows; row++ {
+		for col, cols := 0, tableCols(table, row); col < cols; col++ {
+			rowSpan, colSpan := table.RowSpan(row, col), table.ColSpan(row, col)
+			if rowSpan < 2 && colSpan < 2 {
+				continue
+			}
+			if rowSpan < 1 {
+				rowSpan = 1
+			}
+			if colSpan < 1 {
+				colSpan = 1
+			}
+			span := cellRange{row, col, row + rowSpan - 1, col + colSpan - 1}
+			if span.overlaps(m) {
+				return span, true
+			}
+		}
+	}
+	return cellRange{}, false
+}
+
+// applyMerge sets the spans of the merged range of table and blanks and hides its covered cells.
+func (g *GuiBuilder) applyMerge(table gwu.Table, m cellRange) {
+	table.SetRowSpan(m.fromRow, m.fromCol, m.toRow-m.fromRow+1)
+	table.SetColSpan(m.fromRow, m.fromCol, m.toCol-m.fromCol+1)
+
+	for row := m.fromRow; row <= m.toRow; row++ {
+		for col := m.fromCol; col <= m.toCol; col++ {
+			if row == m.fromRow && col == m.fromCol {
+				continue
+			}
+			if comp := table.CompAt(row, col); comp != nil {
+				table.Remove(comp)
+				g.ForgetTree(comp)
+			}
+			table.CellFmt(row, col).Style().SetDisplay(gwu.DisplayNone)
+		}
+	}
+}
+
+// removeMergeRow shrinks the merges of table spanning the row, moves those below it up and returns the remaining
+// merges.
+func (g *GuiBuilder) removeMergeRow(table gwu.Table, row int) []cellRange {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	r := g.recipes[table.ID()]
+	if r == nil {
+		return nil
+	}
+
+	merges := r.merges[:0]
+	for _, m := range r.merges {
+		if m.toRow >= row {
+			m.toRow--
+			if m.fromRow > row {
+				m.fromRow--
+			}
+		}
+		if m.toRow >= m.fromRow {
+			merges = append(merges, m)
+		}
+	}
+	r.merges = merges
+	return append([]cellRange(nil), merges...)
+}
@@ -0,0 +1,100 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MergeCells(t *testing.T) {
+	tests := []struct {
+		name                           string
+		fromRow, fromCol, toRow, toCol int
+		wantErr                        bool
+	}{
+		{"row", 0, 0, 0, 2, false},
+		{"column", 0, 1, 2, 1, false},
+		{"block", 1, 1, 2, 2, false},
+		{"grows the table", 2, 2, 3, 4, false},
+		{"single cell", 1, 1, 1, 1, false},
+		{"negative", -1, 0, 0, 0, true},
+		{"reversed", 1, 1, 0, 1, true},
+		{"overlaps merge", 0, 0, 1, 0, true},
+		{"overlaps span", 2, 0, 2, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			table := g.MakeTable(Options{Rows: 3, Cols: 3})
+			for row := 0; row < 3; row++ {
+				for col := 0; col < 3; col++ {
+					table.Add(g.MakeLabel("x", Options{}), row, col)
+				}
+			}
+			if tt.wantErr {
+				assert.NoError(t, g.MergeCells(table, 0, 0, 0, 0))
+				assert.NoError(t, g.MergeCells(table, 1, 0, 1, 0))
+				table.SetColSpan(0, 0, 2)
+				table.SetRowSpan(1, 0, 2)
+				assert.Error(t, g.MergeCells(table, tt.fromRow, tt.fromCol, tt.toRow, tt.toCol))
+				return
+			}
+
+			anchor := table.CompAt(tt.fromRow, tt.fromCol)
+			assert.NoError(t, g.MergeCells(table, tt.fromRow, tt.fromCol, tt.toRow, tt.toCol))
+			assert.Equal(t, anchor, table.CompAt(tt.fromRow, tt.fromCol))
+			wantRowSpan, wantColSpan := tt.toRow-tt.fromRow+1, tt.toCol-tt.fromCol+1
+			if wantRowSpan < 2 {
+				wantRowSpan = -1
+			}
+			if wantColSpan < 2 {
+				wantColSpan = -1
+			}
+			assert.Equal(t, wantRowSpan, table.RowSpan(tt.fromRow, tt.fromCol))
+			assert.Equal(t, wantColSpan, table.ColSpan(tt.fromRow, tt.fromCol))
+			for row := tt.fromRow; row <= tt.toRow; row++ {
+				for col := tt.fromCol; col <= tt.toCol; col++ {
+					if row == tt.fromRow && col == tt.fromCol {
+						assert.Equal(t, "", table.CellFmt(row, col).Style().Display())
+						continue
+					}
+					assert.Nil(t, table.CompAt(row, col))
+					assert.Equal(t, gwu.DisplayNone, table.CellFmt(row, col).Style().Display())
+				}
+			}
+			// cells outside the range are kept
+			assert.NotNil(t, table.CompAt(2, 0))
+
+			if tt.toRow > tt.fromRow || tt.toCol > tt.fromCol {
+				assert.Error(t, g.MergeCells(table, tt.toRow, tt.toCol, tt.toRow+1, tt.toCol+1))
+			}
+		})
+	}
+}
+
+func TestGuiBuilder_MergeCells_rows(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+	table := g.MakeTable(Options{Rows: 4, Cols: 2})
+	assert.NoError(t, g.MergeCells(table, 0, 0, 1, 1))
+	assert.NoError(t, g.MergeCells(table, 2, 0, 3, 0))
+
+	clone := g.CloneTree(table).(gwu.Table)
+	assert.Equal(t, 2, clone.RowSpan(0, 0))
+	assert.Equal(t, 2, clone.ColSpan(0, 0))
+	assert.Equal(t, gwu.DisplayNone, clone.CellFmt(1, 1).Style().Display())
+	assert.Equal(t, 2, clone.RowSpan(2, 0))
+
+	g.RemoveTableRow(table, 1)
+	assert.Equal(t, -1, table.RowSpan(0, 0))
+	assert.Equal(t, 2, table.ColSpan(0, 0))
+	assert.Equal(t, gwu.DisplayNone, table.CellFmt(0, 1).Style().Display())
+	assert.Equal(t, 2, table.RowSpan(1, 0))
+	assert.Equal(t, gwu.DisplayNone, table.CellFmt(2, 0).Style().Display())
+
+	g.RemoveTableRow(table, 1)
+	assert.Equal(t, -1, table.RowSpan(1, 0))
+	assert.Equal(t, "", table.CellFmt(1, 0).Style().Display())
+	assert.NoError(t, g.MergeCells(table, 1, 0, 1, 1))
+}
@@ -0,0 +1,69 @@
+package wgowut
+
+import (
+	"fmt"
+
+	"github.com/icza/gowut/gwu"
+)
+
+const (
+	// hstackClass is the style class of the horizontal panels stacked vertically on narrow screens.
+	hstackClass = "wgowut-hstack"
+	// touchTargetClass is the style class of the clickable components enlarged on narrow screens.
+	touchTargetClass = "wgowut-touch"
+)
+
+// MobileOptions configure the mobile layout of a GuiBuilder, see GuiBuilder.SetMobileLayout. The layout only changes
+// on screens at most MaxWidth pixels wide, so the same windows work on desktops and phones.
+type MobileOptions struct {
+	// MaxWidth is the width hint: the largest viewport width in pixels the mobile layout applies to, for example 600.
+	// The mobile layout is off if 0.
+	MaxWidth int
+
+	// MinTargetSize is the minimum width and height of clickable components (buttons, list boxes, text boxes, check
+	// boxes) on narrow screens, for example "48px".
+	MinTargetSize string
+}
+
+// SetMobileLayout applies the mobile layout to all windows and components made by g from now on: windows get a
+// viewport meta tag and a style sheet that, on screens at most MaxWidth pixels wide, stacks the cells of horizontal
+// panels (see MakePanel) vertically and enlarges clickable components to MinTargetSize. Use a session builder (see
+// NewSessionBuilder) to toggle it per session, and the zero MobileOptions to turn it off.
+func (g *GuiBuilder) SetMobileLayout(mobile MobileOptions) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.mobile = mobile
+}
+
+// MobileLayout returns the mobile options set with SetMobileLayout.
+func (g *GuiBuilder) MobileLayout() MobileOptions {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.mobile
+}
+
+// addMobileHead adds the viewport meta tag and the style sheet of the mobile layout to win. The style sheet also
+// collapses sidebar navigations (see MakeSidebarNav) into their menu buttons on narrow screens.
+func (g *GuiBuilder) addMobileHead(win gwu.Window) {
+	mobile := g.MobileLayout()
+	if mobile.MaxWidth <= 0 {
+		return
+	}
+
+	css := fmt.Sprintf("table.%[1]s > tbody > tr > td, table.%[1]s > tr > td {display: block; width: auto !important}", hstackClass)
+	if mobile.MinTargetSize != "" {
+		css += fmt.Sprintf(" .%s {min-width: %s; min-height: %s}", touchTargetClass, mobile.MinTargetSize, mobile.MinTargetSize)
+	}
+	css += fmt.Sprintf(" .%s {display: inline-block} .%s:not(.%s) {display: none}", sidebarToggleClass, sidebarItemsClass, sidebarOpenClass)
+	win.AddHeadHTML(`<meta name="viewport" content="width=device-width, initial-scale=1">`)
+	win.AddHeadHTML(fmt.Sprintf("<style>.%s {display: none} @media (max-width: %dpx) {%s}</style>", sidebarToggleClass, mobile.MaxWidth, css))
+}
+
+// stackOnMobile makes a horizontal panel stack its cells vertically in the mobile layout.
+func (g *GuiBuilder) stackOnMobile(panel gwu.Panel) {
+	if g.MobileLayout().MaxWidth > 0 {
+		panel.Style().AddClass(hstackClass)
+	}
+}
@@ -0,0 +1,46 @@
+package wgowut
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+// renderHTML returns the HTML rendered by comp.
+func renderHTML(comp gwu.Comp) string {
+	var buf bytes.Buffer
+	comp.Render(gwu.NewWriter(&buf))
+	return buf.String()
+}
+
+func TestGuiBuilder_SetMobileLayout(t *testing.T) {
+	g := &GuiBuilder{}
+	assert.Equal(t, MobileOptions{}, g.MobileLayout())
+
+	// nothing changes with the mobile layout off
+	var buf bytes.Buffer
+	g.MakeWindow("main", "Main", Options{}).RenderWin(gwu.NewWriter(&buf), gwu.NewServer("app", ""))
+	assert.NotContains(t, buf.String(), "@media")
+	assert.NotContains(t, renderHTML(g.MakePanel(Options{Layout: LayoutHorizontal})), hstackClass)
+
+	mobile := MobileOptions{MaxWidth: 600, MinTargetSize: "48px"}
+	g.SetMobileLayout(mobile)
+	assert.Equal(t, mobile, g.MobileLayout())
+
+	buf.Reset()
+	g.MakeWindow("main", "Main", Options{}).RenderWin(gwu.NewWriter(&buf), gwu.NewServer("app", ""))
+	assert.Contains(t, buf.String(), `<meta name="viewport"`)
+	assert.Contains(t, buf.String(), "@media (max-width: 600px) {table.wgowut-hstack > tbody > tr > td")
+	assert.Contains(t, buf.String(), ".wgowut-touch {min-width: 48px; min-height: 48px}")
+
+	assert.Contains(t, renderHTML(g.MakePanel(Options{Layout: LayoutHorizontal})), hstackClass)
+	assert.NotContains(t, renderHTML(g.MakePanel(Options{Layout: LayoutVertical})), hstackClass)
+	assert.Contains(t, renderHTML(g.MakeButton("OK", Options{})), touchTargetClass)
+	assert.NotContains(t, renderHTML(g.MakeLabel("text", Options{})), touchTargetClass)
+
+	// touch targets are only enlarged with a MinTargetSize
+	g.SetMobileLayout(MobileOptions{MaxWidth: 600})
+	assert.NotContains(t, renderHTML(g.MakeButton("OK", Options{})), touchTargetClass)
+}
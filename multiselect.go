@@ -0,0 +1,129 @@
+package wgowut
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// MultiSelectRows is the default number of visible rows of the list of a SearchableMultiSelect.
+const MultiSelectRows = 10
+
+// SearchableMultiSelect is a gwu.Panel created by GuiBuilder.MakeSearchableMultiSelect holding a filter text box over
+// a multi-select list box. The selection is kept for the values hidden by the filter.
+type SearchableMultiSelect struct {
+	gwu.Panel
+
+	values   []string
+	selected map[string]bool
+	visible  []string
+	filter   gwu.TextBox
+	lb       gwu.ListBox
+	count    gwu.Label
+}
+
+// MakeSearchableMultiSelect creates a SearchableMultiSelect listing values with none selected. As the user types in
+// the filter text box, the list is narrowed to the values containing the text (ignoring case); the values selected
+// before stay selected when they are filtered out, and a label below the list tells the number of selected values.
+// The list box is made with MakeListBox using options with Multi set; Rows defaults to MultiSelectRows.
+func (g *GuiBuilder) MakeSearchableMultiSelect(values []string, options Options) *SearchableMultiSelect {
+	options.Multi = true
+	if options.Rows == 0 {
+		options.Rows = MultiSelectRows
+	}
+
+	ms := &SearchableMultiSelect{
+		Panel:    g.MakePanel(Options{Layout: LayoutVertical, CellPadding: 2}),
+		values:   append([]string(nil), values...),
+		selected: make(map[string]bool),
+		filter:   g.MakeTextBox("", Options{Width: options.Width}),
+		lb:       g.MakeListBox(nil, options),
+		count:    g.MakeLabel("", Options{Color: gwu.ClrGray, FontSize: "smaller"}),
+	}
+
+	ms.filter.SetAttr("placeholder", "Filter")
+	setAriaLabel(ms.filter, "Filter")
+	ms.filter.AddSyncOnETypes(gwu.ETypeKeyUp)
+	ms.filter.AddEHandlerFunc(ms.handleFilter, gwu.ETypeKeyUp, gwu.ETypeChange)
+	ms.lb.AddEHandlerFunc(ms.handleSelect, gwu.ETypeChange)
+
+	ms.applyFilter()
+	g.AddCompsToPanel(ms.Panel, ms.filter, ms.lb, ms.count)
+
+	return ms
+}
+
+// SelectedValues returns the selected values in the order of the values, including those hidden by the filter.
+func (ms *SearchableMultiSelect) SelectedValues() []string {
+	var selected []string
+	for _, value := range ms.values {
+		if ms.selected[value] {
+			selected = append(selected, value)
+		}
+	}
+	return selected
+}
+
+// SetSelected selects values, deselecting the others. Values not in the list are ignored. Mark the multi-select dirty
+// after calling this from an event handler.
+func (ms *SearchableMultiSelect) SetSelected(values []string) {
+	ms.selected = make(map[string]bool)
+	for _, value := range values {
+		ms.selected[value] = true
+	}
+	for value := range ms.selected {
+		if !ms.contains(value) {
+			delete(ms.selected, value)
+		}
+	}
+	ms.applyFilter()
+}
+
+func (ms *SearchableMultiSelect) contains(value string) bool {
+	for _, v := range ms.values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// applyFilter lists the values matching the filter, selecting the selected ones, and updates the count.
+func (ms *SearchableMultiSelect) applyFilter() {
+	filter := strings.ToLower(strings.TrimSpace(ms.filter.Text()))
+	ms.visible = ms.visible[:0]
+	var indices []int
+	for _, value := range ms.values {
+		if filter != "" && !strings.Contains(strings.ToLower(value), filter) {
+			continue
+		}
+		if ms.selected[value] {
+			indices = append(indices, len(ms.visible))
+		}
+		ms.visible = append(ms.visible, value)
+	}
+
+	ms.lb.SetValues(append([]string(nil), ms.visible...))
+	ms.lb.SetSelectedIndices(indices)
+	ms.count.SetText(strconv.Itoa(len(ms.selected)) + " selected")
+}
+
+func (ms *SearchableMultiSelect) handleFilter(e gwu.Event) {
+	ms.applyFilter()
+	e.MarkDirty(ms.lb, ms.count)
+}
+
+// handleSelect updates the selection of the visible values from the list box.
+func (ms *SearchableMultiSelect) handleSelect(e gwu.Event) {
+	for _, value := range ms.visible {
+		delete(ms.selected, value)
+	}
+	for _, i := range ms.lb.SelectedIndices() {
+		if i < len(ms.visible) {
+			ms.selected[ms.visible[i]] = true
+		}
+	}
+	ms.count.SetText(strconv.Itoa(len(ms.selected)) + " selected")
+	e.MarkDirty(ms.count)
+}
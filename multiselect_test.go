@@ -0,0 +1,48 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeSearchableMultiSelect(t *testing.T) {
+	g := &GuiBuilder{}
+	ms := g.MakeSearchableMultiSelect([]string{"apple", "apricot", "banana", "cherry"}, Options{Width: "150px"})
+
+	assert.True(t, ms.lb.Multi())
+	assert.Equal(t, MultiSelectRows, ms.lb.Rows())
+	assert.Equal(t, "150px", ms.filter.Style().Width())
+	assert.Equal(t, []string{"apple", "apricot", "banana", "cherry"}, ms.lb.Values())
+	assert.Empty(t, ms.SelectedValues())
+	assert.Equal(t, "0 selected", ms.count.Text())
+
+	ms.lb.SetSelectedIndices([]int{1, 3})
+	e := &testEvent{etype: gwu.ETypeChange, src: ms.lb}
+	ms.handleSelect(e)
+	assert.Equal(t, []string{"apricot", "cherry"}, ms.SelectedValues())
+	assert.Equal(t, "2 selected", ms.count.Text())
+	assert.Equal(t, []gwu.Comp{ms.count}, e.dirty)
+
+	// the selection survives filtering
+	ms.filter.SetText("AP")
+	e = &testEvent{etype: gwu.ETypeKeyUp, src: ms.filter}
+	ms.handleFilter(e)
+	assert.Equal(t, []string{"apple", "apricot"}, ms.lb.Values())
+	assert.Equal(t, []int{1}, ms.lb.SelectedIndices())
+	assert.Equal(t, []gwu.Comp{ms.lb, ms.count}, e.dirty)
+
+	ms.lb.SetSelectedIndices([]int{0})
+	ms.handleSelect(&testEvent{etype: gwu.ETypeChange, src: ms.lb})
+	assert.Equal(t, []string{"apple", "cherry"}, ms.SelectedValues())
+
+	ms.filter.SetText("")
+	ms.handleFilter(&testEvent{etype: gwu.ETypeKeyUp, src: ms.filter})
+	assert.Equal(t, []int{0, 3}, ms.lb.SelectedIndices())
+
+	ms.SetSelected([]string{"banana", "missing"})
+	assert.Equal(t, []string{"banana"}, ms.SelectedValues())
+	assert.Equal(t, []int{2}, ms.lb.SelectedIndices())
+	assert.Equal(t, "1 selected", ms.count.Text())
+}
@@ -0,0 +1,161 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// Node is a composable description of a component tree that GuiBuilder.Build renders into nested panels and tables,
+// keeping the structure of a layout visible at a glance. For example:
+//
+//	comp := gc.Build(wgowut.Col(
+//		wgowut.Row(wgowut.Label("Name"), wgowut.Button("OK", onClick)),
+//		wgowut.Table(
+//			wgowut.Row(wgowut.Label("a"), wgowut.Label("b")),
+//			wgowut.Row(wgowut.Label("c"), wgowut.Label("d")),
+//		),
+//	).With(wgowut.Options{CellPadding: 5}))
+type Node interface {
+	// With returns the Node with the given options, which are passed to the Make function used to build it.
+	With(options Options) Node
+
+	build(g *GuiBuilder) gwu.Comp
+}
+
+type panelNode struct {
+	layout   Layout
+	options  Options
+	children []Node
+}
+
+type labelNode struct {
+	text    string
+	options Options
+}
+
+type buttonNode struct {
+	text    string
+	onClick func(gwu.Event)
+	options Options
+}
+
+type tableNode struct {
+	options Options
+	rows    []Node
+}
+
+type compNode struct {
+	comp gwu.Comp
+}
+
+// Col returns a Node rendered as a gwu.Panel that stacks its children vertically.
+func Col(children ...Node) Node {
+	return &panelNode{layout: LayoutVertical, children: children}
+}
+
+// Row returns a Node rendered as a gwu.Panel that lays out its children horizontally. When used as a child of Table,
+// each of its children is placed in its own cell of a single table row instead.
+func Row(children ...Node) Node {
+	return &panelNode{layout: LayoutHorizontal, children: children}
+}
+
+// Label returns a Node rendered with MakeLabel.
+func Label(text string) Node {
+	return &labelNode{text: text}
+}
+
+// Button returns a Node rendered with MakeButton. If onClick is not nil, it is added as the ETypeClick handler.
+func Button(text string, onClick func(gwu.Event)) Node {
+	return &buttonNode{text: text, onClick: onClick}
+}
+
+// Table returns a Node rendered with MakeTable. Row children fill one table row each, one child per cell; any other
+// child fills the first cell of its own row. Rows and Cols are calculated from the children.
+func Table(rows ...Node) Node {
+	return &tableNode{rows: rows}
+}
+
+// Comp returns a Node for an already created component, allowing components built elsewhere (and stored for later
+// use, like inputs) to be placed in a Node tree. Options are ignored.
+func Comp(comp gwu.Comp) Node {
+	return &compNode{comp: comp}
+}
+
+// Build renders the Node tree into gwu components and returns the root component.
+func (g *GuiBuilder) Build(node Node) gwu.Comp {
+	return node.build(g)
+}
+
+func (n *panelNode) With(options Options) Node {
+	n.options = options
+	return n
+}
+
+func (n *panelNode) build(g *GuiBuilder) gwu.Comp {
+	options := n.options
+	options.Layout = n.layout
+	panel := g.MakePanel(options)
+
+	for _, child := range n.children {
+		panel.Add(child.build(g))
+	}
+
+	return panel
+}
+
+func (n *labelNode) With(options Options) Node {
+	n.options = options
+	return n
+}
+
+func (n *labelNode) build(g *GuiBuilder) gwu.Comp {
+	return g.MakeLabel(n.text, n.options)
+}
+
+func (n *buttonNode) With(options Options) Node {
+	n.options = options
+	return n
+}
+
+func (n *buttonNode) build(g *GuiBuilder) gwu.Comp {
+	return g.MakeButtonWithHandler(n.text, n.options, n.onClick)
+}
+
+func (n *tableNode) With(options Options) Node {
+	n.options = options
+	return n
+}
+
+func (n *tableNode) build(g *GuiBuilder) gwu.Comp {
+	cells := make([][]Node, len(n.rows))
+	cols := 0
+	for i, row := range n.rows {
+		if panel, ok := row.(*panelNode); ok && panel.layout == LayoutHorizontal {
+			cells[i] = panel.children
+		} else {
+			cells[i] = []Node{row}
+		}
+		if len(cells[i]) > cols {
+			cols = len(cells[i])
+		}
+	}
+
+	options := n.options
+	options.Rows, options.Cols = len(n.rows), cols
+	table := g.MakeTable(options)
+
+	for row, rowCells := range cells {
+		for col, cell := range rowCells {
+			table.Add(cell.build(g), row, col)
+		}
+	}
+
+	return table
+}
+
+func (n *compNode) With(options Options) Node {
+	return n
+}
+
+func (n *compNode) build(g *GuiBuilder) gwu.Comp {
+	return n.comp
+}
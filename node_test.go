@@ -0,0 +1,62 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_Build(t *testing.T) {
+
+	existing := gwu.NewTextBox("existing")
+
+	tests := []struct {
+		name  string
+		node  Node
+		check func(t *testing.T, got gwu.Comp)
+	}{
+		{"col of row and table", Col(
+			Row(Label("x").With(Options{Color: gwu.ClrRed}), Button("ok", func(e gwu.Event) {})),
+			Table(
+				Row(Label("a"), Label("b")),
+				Comp(existing),
+			).With(Options{CellPadding: 2}),
+		).With(Options{CellPadding: 5, Layout: LayoutNatural}),
+			func(t *testing.T, got gwu.Comp) {
+				col := got.(gwu.Panel)
+				assert.Equal(t, gwu.LayoutVertical, col.Layout())
+				assert.Equal(t, 5, col.CellPadding())
+				assert.Equal(t, 2, col.CompsCount())
+
+				row := col.CompAt(0).(gwu.Panel)
+				assert.Equal(t, gwu.LayoutHorizontal, row.Layout())
+				label := row.CompAt(0).(gwu.Label)
+				assert.Equal(t, "x", label.Text())
+				assert.Equal(t, gwu.ClrRed, label.Style().Color())
+				btn := row.CompAt(1).(gwu.Button)
+				assert.Equal(t, "ok", btn.Text())
+				assert.Equal(t, 1, btn.HandlersCount(gwu.ETypeClick))
+
+				table := col.CompAt(1).(gwu.Table)
+				assert.Equal(t, 2, table.CellPadding())
+				assert.Equal(t, "a", table.CompAt(0, 0).(gwu.Label).Text())
+				assert.Equal(t, "b", table.CompAt(0, 1).(gwu.Label).Text())
+				assert.Equal(t, existing, table.CompAt(1, 0))
+			}},
+		{"button without handler", Button("no handler", nil),
+			func(t *testing.T, got gwu.Comp) {
+				assert.Equal(t, 0, got.HandlersCount(gwu.ETypeClick))
+			}},
+		{"empty col", Col(),
+			func(t *testing.T, got gwu.Comp) {
+				assert.Equal(t, 0, got.(gwu.Panel).CompsCount())
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			tt.check(t, g.Build(tt.node))
+		})
+	}
+}
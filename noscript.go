@@ -0,0 +1,88 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// NoScriptClass is the style class of the banner added by AddNoScriptFallback. It's hidden unless JavaScript is
+// disabled, so it can be restyled from a window or theme style sheet.
+const NoScriptClass = "wgowut-noscript"
+
+// noScriptHeadHTML shows the banner when JavaScript is disabled.
+const noScriptHeadHTML = `<noscript><style>.` + NoScriptClass + ` {display: block !important}</style></noscript>`
+
+// AddNoScriptFallback keeps win usable for reading in browsers with JavaScript disabled, like locked-down corporate
+// ones. A banner with message (styled with the NoScriptClass style class) is inserted at the top of the window and shown
+// by noscript head HTML only when JavaScript is disabled. gwu renders labels and tables server side so they stay
+// readable, but the content of collapsed expanders and unselected tabs is only rendered after an event; the critical
+// components among them are rendered below the banner, with their current content, when JavaScript is disabled.
+func (g *GuiBuilder) AddNoScriptFallback(win gwu.Window, message string, critical ...gwu.Comp) {
+	win.AddHeadHTML(noScriptHeadHTML)
+
+	banner := g.MakeLabel(message, Options{Color: gwu.ClrWhite, Background: gwu.ClrMaroon, FontSize: "120%"})
+	banner.Style().AddClass(NoScriptClass)
+	banner.Style().SetDisplay(gwu.DisplayNone)
+	banner.Style().SetPadding("8px")
+	win.Insert(banner, 0)
+
+	if len(critical) > 0 {
+		win.Insert(&noScriptView{HTML: gwu.NewHTML(""), comps: critical}, 1)
+	}
+}
+
+// noScriptView renders the critical components passed to AddNoScriptFallback that are not displayed in a noscript
+// element. It's rendered with the window so the components are up to date.
+type noScriptView struct {
+	gwu.HTML
+	comps []gwu.Comp
+}
+
+func (v *noScriptView) Render(w gwu.Writer) {
+	root := gwu.Comp(v)
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+
+	w.Writes("<noscript>")
+	for _, comp := range v.comps {
+		if !isDisplayed(root, comp) {
+			comp.Render(w)
+		}
+	}
+	w.Writes("</noscript>")
+}
+
+// isDisplayed reports if comp is rendered with root, which is not the case for the content of collapsed expanders and
+// of unselected tabs. It searches from root down as the parent of a tab content is not the tab panel.
+func isDisplayed(root, comp gwu.Comp) bool {
+	if root == comp {
+		return true
+	}
+	for _, child := range displayedChildren(root) {
+		if isDisplayed(child, comp) {
+			return true
+		}
+	}
+	return false
+}
+
+// displayedChildren returns the child components rendered with comp.
+func displayedChildren(comp gwu.Comp) []gwu.Comp {
+	switch c := comp.(type) {
+	case gwu.Expander:
+		if !c.Expanded() {
+			var children []gwu.Comp
+			if c.Header() != nil {
+				children = append(children, c.Header())
+			}
+			return children
+		}
+	case gwu.TabPanel:
+		children := childComps(c.TabBar())
+		if selected := c.Selected(); selected >= 0 {
+			children = append(children, c.CompAt(selected))
+		}
+		return children
+	}
+	return childComps(comp)
+}
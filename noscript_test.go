@@ -0,0 +1,62 @@
+package wgowut
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_AddNoScriptFallback(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "Main", Options{})
+	status := g.MakeLabel("status ok", Options{})
+	details := g.MakeLabel("details", Options{})
+	expander := gwu.NewExpander()
+	expander.SetHeader(g.MakeLabel("more", Options{}))
+	expander.SetContent(details)
+	tabs := gwu.NewTabPanel()
+	overview, report := g.MakeLabel("overview", Options{}), g.MakeLabel("report", Options{})
+	tabs.AddString("Overview", overview)
+	tabs.AddString("Report", report)
+	win.Add(status)
+	win.Add(expander)
+	win.Add(tabs)
+
+	g.AddNoScriptFallback(win, "Enable JavaScript to edit.", status, details, overview, report)
+
+	banner := win.CompAt(0).(gwu.Label)
+	assert.Equal(t, "Enable JavaScript to edit.", banner.Text())
+	assert.Equal(t, gwu.DisplayNone, banner.Style().Display())
+	var buf bytes.Buffer
+	banner.Render(gwu.NewWriter(&buf))
+	assert.Contains(t, buf.String(), NoScriptClass)
+
+	buf.Reset()
+	win.RenderWin(gwu.NewWriter(&buf), gwu.NewServer("app", ""))
+	assert.Contains(t, buf.String(), noScriptHeadHTML)
+
+	noScript := func() string {
+		var buf bytes.Buffer
+		win.CompAt(1).Render(gwu.NewWriter(&buf))
+		html := buf.String()
+		assert.True(t, strings.HasPrefix(html, "<noscript>") && strings.HasSuffix(html, "</noscript>"), html)
+		return html
+	}
+
+	// only the critical comps that are not displayed are rendered in the noscript element
+	html := noScript()
+	assert.Contains(t, html, "details")
+	assert.Contains(t, html, "report")
+	assert.NotContains(t, html, "status ok")
+	assert.NotContains(t, html, "overview")
+
+	expander.SetExpanded(true)
+	tabs.SetSelected(1)
+	html = noScript()
+	assert.NotContains(t, html, "details")
+	assert.NotContains(t, html, "report")
+	assert.Contains(t, html, "overview")
+}
@@ -0,0 +1,176 @@
+package wgowut
+
+import (
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// NotifyLevel is the level of a notification, selecting its colors.
+type NotifyLevel int
+
+// NotifyLevel constants
+const (
+	NotifyInfo NotifyLevel = iota
+	NotifySuccess
+	NotifyWarning
+	NotifyError
+)
+
+// DefaultNotifyDuration is how long notifications are displayed by default, see NewNotifier.
+const DefaultNotifyDuration = 5 * time.Second
+
+// notifyColors holds the text, background and border colors of the notifications by level.
+var notifyColors = map[NotifyLevel][3]string{
+	NotifyInfo:    {"#084298", "#CFE2FF", "#9EC5FE"},
+	NotifySuccess: {"#0F5132", "#D1E7DD", "#A3CFBB"},
+	NotifyWarning: {"#664D03", "#FFF3CD", "#FFE69C"},
+	NotifyError:   {"#842029", "#F8D7DA", "#F1AEB5"},
+}
+
+// toast is a displayed notification.
+type toast struct {
+	label   gwu.Label
+	expires time.Time
+}
+
+// Notifier displays notifications (toasts) in the top right corner of a window, hiding each after a duration. Create
+// it with NewNotifier.
+type Notifier struct {
+	g        *GuiBuilder
+	panel    gwu.Panel
+	timer    gwu.Timer
+	duration time.Duration
+	toasts   []toast
+	now      func() time.Time
+}
+
+// notifyPanel is the panel of a Notifier in its window, so the notifier of a window can be found, see windowNotifier.
+type notifyPanel struct {
+	gwu.Panel
+	n *Notifier
+}
+
+// NewNotifier adds a corner panel for notifications to win, and returns the notifier displaying them for
+// DefaultNotifyDuration. Notifications are hidden by a gwu.Timer added to win; clicking one hides it right away.
+func (g *GuiBuilder) NewNotifier(win gwu.Window) *Notifier {
+	return g.newNotifier(win)
+}
+
+// newNotifier adds the panel and the timer of a new notifier to root, a window or the panel embedded by a window.
+func (g *GuiBuilder) newNotifier(root gwu.Panel) *Notifier {
+	n := &Notifier{g: g, duration: DefaultNotifyDuration, now: time.Now}
+
+	n.panel = &notifyPanel{Panel: g.MakePanel(Options{Layout: LayoutVertical, CellPadding: 4}), n: n}
+	n.panel.Style().Set("position", "fixed").Set("top", "10px").Set("right", "10px").Set("z-index", "1100")
+	n.panel.SetAttr("aria-live", "polite")
+
+	n.timer = gwu.NewTimer(n.duration)
+	n.timer.SetActive(false)
+	n.timer.AddEHandlerFunc(n.expireHandler, gwu.ETypeStateChange)
+
+	root.Add(n.panel)
+	root.Add(n.timer)
+	return n
+}
+
+// SetDuration sets how long the notifications made from now on are displayed.
+func (n *Notifier) SetDuration(duration time.Duration) {
+	n.duration = duration
+}
+
+// Notify displays message colored by level on top of the other notifications, and marks the notifier dirty if e is
+// not nil. Call it from event handlers with their event; the notification is displayed when the window is rendered
+// otherwise.
+func (n *Notifier) Notify(e gwu.Event, level NotifyLevel, message string) {
+	colors, ok := notifyColors[level]
+	if !ok {
+		colors = notifyColors[NotifyInfo]
+	}
+	label := n.g.MakeLabel(message, Options{Color: colors[0], Background: colors[1], BorderWidth: 1,
+		BorderStyle: gwu.BrdStyleSolid, BorderColor: colors[2]})
+	label.Style().SetDisplay(gwu.DisplayBlock).SetPadding("8px 12px").Set("min-width", "200px").Set("cursor", "pointer").
+		Set("border-radius", "4px")
+	label.SetAttr("role", "status")
+	if level == NotifyError {
+		label.SetAttr("role", "alert")
+	}
+	label.AddEHandlerFunc(n.dismissHandler(label), gwu.ETypeClick)
+
+	n.panel.Insert(label, 0)
+	n.toasts = append(n.toasts, toast{label, n.now().Add(n.duration)})
+	n.schedule()
+	n.markDirty(e)
+}
+
+// Count returns the number of notifications displayed.
+func (n *Notifier) Count() int {
+	return len(n.toasts)
+}
+
+// schedule sets the timer to fire when the first notification expires, or deactivates it if there are none.
+func (n *Notifier) schedule() {
+	if len(n.toasts) == 0 {
+		n.timer.SetActive(false)
+		return
+	}
+
+	first := n.toasts[0].expires
+	for _, t := range n.toasts[1:] {
+		if t.expires.Before(first) {
+			first = t.expires
+		}
+	}
+	timeout := first.Sub(n.now())
+	if timeout < time.Millisecond {
+		timeout = time.Millisecond
+	}
+	n.timer.SetTimeout(timeout)
+	n.timer.SetActive(true)
+	n.timer.Reset()
+}
+
+// remove hides the notifications keep returns false for.
+func (n *Notifier) remove(keep func(t toast) bool) {
+	kept := n.toasts[:0]
+	for _, t := range n.toasts {
+		if keep(t) {
+			kept = append(kept, t)
+		} else {
+			n.panel.Remove(t.label)
+			n.g.ForgetTree(t.label)
+		}
+	}
+	n.toasts = kept
+	n.schedule()
+}
+
+func (n *Notifier) markDirty(e gwu.Event) {
+	if e != nil {
+		e.MarkDirty(n.panel, n.timer)
+	}
+}
+
+func (n *Notifier) expireHandler(e gwu.Event) {
+	now := n.now()
+	n.remove(func(t toast) bool { return t.expires.After(now) })
+	n.markDirty(e)
+}
+
+func (n *Notifier) dismissHandler(label gwu.Label) func(gwu.Event) {
+	return func(e gwu.Event) {
+		n.remove(func(t toast) bool { return t.label != label })
+		n.markDirty(e)
+	}
+}
+
+// windowNotifier returns the notifier made by NewNotifier in the window of root, or nil.
+func windowNotifier(root gwu.Comp) *Notifier {
+	var n *Notifier
+	walkComps(root, func(c gwu.Comp) {
+		if p, ok := c.(*notifyPanel); ok && n == nil {
+			n = p.n
+		}
+	})
+	return n
+}
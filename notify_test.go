@@ -0,0 +1,63 @@
+package wgowut
+
+import (
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_NewNotifier(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+	win := g.MakeWindow("main", "Main", Options{})
+	n := g.NewNotifier(win)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	n.now = func() time.Time { return now }
+
+	assert.Equal(t, 2, win.CompsCount())
+	assert.Equal(t, "fixed", n.panel.Style().Get("position"))
+	assert.False(t, n.timer.Active())
+
+	e := &testEvent{etype: gwu.ETypeClick}
+	n.Notify(e, NotifySuccess, "Saved")
+	assert.Equal(t, []gwu.Comp{n.panel, n.timer}, e.dirty)
+	assert.Equal(t, 1, n.Count())
+	assert.True(t, n.timer.Active())
+	assert.Equal(t, DefaultNotifyDuration, n.timer.Timeout())
+	saved := n.panel.CompAt(0).(gwu.Label)
+	assert.Equal(t, "Saved", saved.Text())
+	assert.Equal(t, notifyColors[NotifySuccess][1], saved.Style().Background())
+
+	now = now.Add(2 * time.Second)
+	n.SetDuration(time.Second)
+	n.Notify(nil, NotifyError, "Connection lost")
+	assert.Equal(t, 2, n.Count())
+	assert.Equal(t, "Connection lost", n.panel.CompAt(0).(gwu.Label).Text()) // newest on top
+	assert.Equal(t, "alert", n.panel.CompAt(0).Attr("role"))
+	assert.Equal(t, time.Second, n.timer.Timeout()) // the error expires first
+
+	// the timer hides the expired notifications and is set for the next one
+	now = now.Add(time.Second)
+	e = &testEvent{etype: gwu.ETypeStateChange}
+	n.expireHandler(e)
+	assert.Equal(t, []gwu.Comp{n.panel, n.timer}, e.dirty)
+	assert.Equal(t, 1, n.Count())
+	assert.Equal(t, gwu.Comp(saved), n.panel.CompAt(0))
+	assert.Equal(t, 2*time.Second, n.timer.Timeout())
+
+	n.Notify(nil, NotifyLevel(42), "unknown levels are info")
+	assert.Equal(t, notifyColors[NotifyInfo][1], n.panel.CompAt(0).Style().Background())
+
+	// clicking a notification hides it, discarding its recipe
+	n.dismissHandler(saved)(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, 1, n.Count())
+	assert.Equal(t, 1, n.panel.CompsCount())
+	assert.Nil(t, g.CloneTree(saved))
+
+	now = now.Add(time.Minute)
+	n.expireHandler(&testEvent{etype: gwu.ETypeStateChange})
+	assert.Equal(t, 0, n.Count())
+	assert.False(t, n.timer.Active())
+}
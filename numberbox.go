@@ -0,0 +1,116 @@
+package wgowut
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// NumberBox is an HTML number input whose value is checked on the server. Create it with MakeNumberBox.
+type NumberBox struct {
+	gwu.TextBox
+	min, max, step float64
+	value          float64
+	onChange       func(e gwu.Event, value float64)
+}
+
+// MakeNumberBox creates a number input set to initial. The Min and Max options limit the value if Max is greater than
+// Min, and a positive Step rounds it to whole steps from Min (or from 0 without limits). The value sent by the browser
+// is checked on change: out of range values are clamped, and text that isn't a number is replaced by the last valid
+// value. The following options are also used:
+//
+// BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, Enable, ReadOnly, AutoFocus
+func (g *GuiBuilder) MakeNumberBox(initial float64, options Options) *NumberBox {
+	nb := &NumberBox{TextBox: gwu.NewTextBox(""), min: math.Inf(-1), max: math.Inf(1), step: options.Step}
+	if options.Max > options.Min {
+		nb.min, nb.max = options.Min, options.Max
+		nb.SetAttr("min", formatNumber(nb.min))
+		nb.SetAttr("max", formatNumber(nb.max))
+	}
+	if nb.step > 0 {
+		nb.SetAttr("step", formatNumber(nb.step))
+	} else {
+		nb.SetAttr("step", "any")
+	}
+	nb.SetValue(initial)
+
+	setEnabled(nb, options.Enable)
+	nb.SetReadOnly(options.ReadOnly)
+	setStyle(nb.Style(), g.styleOptions(options))
+	g.enlargeTarget(nb)
+	if options.AutoFocus {
+		autoFocus(nb)
+	}
+	nb.AddEHandlerFunc(nb.changeHandler, gwu.ETypeChange)
+
+	return nb
+}
+
+// Float returns the value of the number box.
+func (nb *NumberBox) Float() float64 {
+	return nb.value
+}
+
+// Int returns the value of the number box rounded to the nearest integer.
+func (nb *NumberBox) Int() int {
+	return int(math.Round(nb.value))
+}
+
+// SetValue sets the value of the number box, clamped to its range and rounded to its steps. Mark the number box dirty
+// after calling this from an event handler.
+func (nb *NumberBox) SetValue(value float64) {
+	nb.value = nb.clamp(value)
+	nb.SetText(formatNumber(nb.value))
+}
+
+// OnChange sets the function called with the new value after the user changes it.
+func (nb *NumberBox) OnChange(fn func(e gwu.Event, value float64)) {
+	nb.onChange = fn
+}
+
+// clamp returns the value of the range of the number box nearest to value.
+func (nb *NumberBox) clamp(value float64) float64 {
+	if nb.step > 0 {
+		origin := nb.min
+		if math.IsInf(origin, -1) {
+			origin = 0
+		}
+		value = origin + math.Round((value-origin)/nb.step)*nb.step
+		if value > nb.max {
+			value -= nb.step
+		}
+		// drop the floating point error of fractional steps, like 0.30000000000000004
+		value, _ = strconv.ParseFloat(strconv.FormatFloat(value, 'g', 15, 64), 64)
+	}
+	return math.Max(nb.min, math.Min(nb.max, value))
+}
+
+func (nb *NumberBox) changeHandler(e gwu.Event) {
+	text := nb.Text()
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		value = nb.value
+	}
+	nb.SetValue(value)
+	if nb.Text() != text {
+		e.MarkDirty(nb)
+	}
+
+	if nb.onChange != nil {
+		nb.onChange(e, nb.value)
+	}
+}
+
+// Render renders the text box of the number box as a number input.
+func (nb *NumberBox) Render(w gwu.Writer) {
+	var buf bytes.Buffer
+	nb.TextBox.Render(gwu.NewWriter(&buf))
+	w.Write(bytes.Replace(buf.Bytes(), []byte(`<input type="text"`), []byte(`<input type="number"`), 1))
+}
+
+// formatNumber formats value with the fewest digits needed.
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,71 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeNumberBox(t *testing.T) {
+	g := &GuiBuilder{}
+	nb := g.MakeNumberBox(7.3, Options{Min: 1, Max: 10, Step: 0.5, Width: "80px"})
+
+	assert.Equal(t, 7.5, nb.Float())
+	assert.Equal(t, 8, nb.Int())
+	assert.Equal(t, "7.5", nb.Text())
+	assert.Equal(t, "80px", nb.Style().Width())
+	html := renderHTML(nb)
+	assert.Contains(t, html, `<input type="number"`)
+	assert.Contains(t, html, `min="1"`)
+	assert.Contains(t, html, `max="10"`)
+	assert.Contains(t, html, `step="0.5"`)
+
+	for value, want := range map[float64]float64{-3: 1, 1.2: 1, 4.26: 4.5, 9.9: 10, 42: 10} {
+		nb.SetValue(value)
+		assert.Equal(t, want, nb.Float(), value)
+	}
+
+	// no limits
+	nb = g.MakeNumberBox(-1234.5678, Options{})
+	assert.Equal(t, -1234.5678, nb.Float())
+	assert.Equal(t, -1235, nb.Int())
+	assert.Contains(t, renderHTML(nb), `step="any"`)
+	assert.NotContains(t, renderHTML(nb), `min=`)
+
+	nb = g.MakeNumberBox(12, Options{Step: 5})
+	assert.Equal(t, 10.0, nb.Float())
+
+	nb = g.MakeNumberBox(0.31, Options{Step: 0.1})
+	assert.Equal(t, "0.3", nb.Text())
+}
+
+func TestNumberBox_changeHandler(t *testing.T) {
+	g := &GuiBuilder{}
+	nb := g.MakeNumberBox(5, Options{Min: 0, Max: 100, Step: 1})
+	var got []float64
+	nb.OnChange(func(e gwu.Event, value float64) { got = append(got, value) })
+
+	nb.SetText("42")
+	e := &testEvent{etype: gwu.ETypeChange}
+	nb.changeHandler(e)
+	assert.Equal(t, 42, nb.Int())
+	assert.Empty(t, e.dirty)
+
+	// out of range values are clamped
+	nb.SetText("250")
+	nb.changeHandler(e)
+	assert.Equal(t, 100, nb.Int())
+	assert.Equal(t, "100", nb.Text())
+	assert.Equal(t, []gwu.Comp{nb}, e.dirty)
+
+	// invalid text is replaced by the last value
+	e = &testEvent{etype: gwu.ETypeChange}
+	nb.SetText("lots")
+	nb.changeHandler(e)
+	assert.Equal(t, 100, nb.Int())
+	assert.Equal(t, "100", nb.Text())
+	assert.Equal(t, []gwu.Comp{nb}, e.dirty)
+
+	assert.Equal(t, []float64{42, 100, 100}, got)
+}
@@ -0,0 +1,32 @@
+package wgowut
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// Once returns an event handler calling fn for the first event only and ignoring all later ones, so a one-shot
+// action (like placing an order) can't be submitted twice by impatient double clicks. It's safe for concurrent use. For
+// actions that may be repeated, use the DisableOnClick option of MakeButton instead.
+func Once(fn func(gwu.Event)) func(gwu.Event) {
+	var once sync.Once
+	return func(e gwu.Event) {
+		once.Do(func() { fn(e) })
+	}
+}
+
+// disableOnClick makes the browser disable btn as soon as it's clicked, until the response of the click event
+// re-renders it. gwu renders its own onclick attribute after the explicitly set ones, where browsers ignore it.
+func disableOnClick(btn gwu.Button) {
+	btn.SetAttr("onclick", fmt.Sprintf("this.disabled=true;se(event,%d,%d);", int(gwu.ETypeClick), int(btn.ID())))
+	btn.AddEHandlerFunc(redrawHandler(btn), gwu.ETypeClick)
+}
+
+// redrawHandler returns an event handler marking comp dirty.
+func redrawHandler(comp gwu.Comp) func(e gwu.Event) {
+	return func(e gwu.Event) {
+		e.MarkDirty(comp)
+	}
+}
@@ -0,0 +1,68 @@
+package wgowut
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnce(t *testing.T) {
+	var events []gwu.Event
+	handler := Once(func(e gwu.Event) { events = append(events, e) })
+
+	first, second := &testEvent{}, &testEvent{}
+	handler(first)
+	handler(second)
+	assert.Equal(t, []gwu.Event{first}, events)
+
+	var mu sync.Mutex
+	count := 0
+	handler = Once(func(e gwu.Event) {
+		mu.Lock()
+		count++
+		mu.Unlock()
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			handler(&testEvent{})
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, count)
+}
+
+func TestGuiBuilder_MakeButton_disableOnClick(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+
+	btn := g.MakeButton("Save", Options{})
+	assert.Equal(t, "", btn.Attr("onclick"))
+	assert.Equal(t, 0, btn.HandlersCount(gwu.ETypeClick))
+
+	btn = g.MakeButton("Save", Options{DisableOnClick: true})
+	onclick := fmt.Sprintf("this.disabled=true;se(event,%d,%d);", int(gwu.ETypeClick), int(btn.ID()))
+	assert.Equal(t, onclick, btn.Attr("onclick"))
+
+	assert.Equal(t, 1, btn.HandlersCount(gwu.ETypeClick))
+	var buf bytes.Buffer
+	btn.Render(gwu.NewWriter(&buf))
+	html := buf.String()
+	assert.True(t, strings.Index(html, `onclick="this.disabled`) < strings.LastIndex(html, "onclick="))
+
+	// the button is re-rendered with the response of the click
+	e := &testEvent{etype: gwu.ETypeClick, src: btn}
+	redrawHandler(btn)(e)
+	assert.Equal(t, []gwu.Comp{btn}, e.dirty)
+
+	clone := g.CloneTree(btn).(gwu.Button)
+	assert.Equal(t, 1, clone.HandlersCount(gwu.ETypeClick))
+	assert.Contains(t, clone.Attr("onclick"), fmt.Sprintf("se(event,%d,%d)", int(gwu.ETypeClick), int(clone.ID())))
+}
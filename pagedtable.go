@@ -0,0 +1,113 @@
+package wgowut
+
+import (
+	"reflect"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// DefaultPageSize is the page size of MakePagedDataTable if the PageSize option is not set.
+const DefaultPageSize = 50
+
+// SortSpec is the sort of a DataTable passed to a LoadPageFunc.
+type SortSpec struct {
+	Col  int  // Col is the index of the sorted column, -1 if the rows are not sorted.
+	Desc bool // Desc is set for descending order.
+}
+
+// LoadPageFunc fetches the rows of the page (starting at 0) of a table made with MakePagedDataTable, sorted by sort,
+// and returns them along with the total number of rows on all pages.
+type LoadPageFunc func(page, size int, sort SortSpec) (rows [][]string, total int, err error)
+
+// MakePagedDataTable creates a DataTable with the given headers whose rows are fetched page by page with loadPage,
+// so huge datasets can stay in the backend instead of memory. loadPage is called with the first page when the table
+// is made and again whenever the page or the sort (see the Sortable option) changes; only the rows of the current page
+// are held. Rows with fewer cells than headers are padded with blank cells. If a page can't be loaded, the previous
+// rows are kept and the error is displayed above the pager until the next successful load.
+//
+// The table is made like with MakeDataTable and uses the same options; the PageSize option is the size passed to
+// loadPage, DefaultPageSize if it's not set. Filters only apply to the rows of the loaded page. An error is returned
+// if the first page can't be loaded.
+func (g *GuiBuilder) MakePagedDataTable(headers []string, loadPage LoadPageFunc, options Options) (*DataTable, error) {
+	if options.PageSize <= 0 {
+		options.PageSize = DefaultPageSize
+	}
+
+	columns := make([]dataColumn, len(headers))
+	for i, header := range headers {
+		columns[i] = dataColumn{name: header, header: header, index: i, sortable: options.Sortable}
+	}
+
+	dt := &DataTable{
+		g:        g,
+		options:  options,
+		columns:  configureColumns(columns, options),
+		sortCol:  -1,
+		loadPage: loadPage,
+	}
+	options.Rows, options.Cols = 1, len(dt.columns)
+	dt.Table = g.MakeTable(options)
+	dt.load()
+	if dt.loadErr != nil {
+		return nil, dt.loadErr
+	}
+
+	return dt, nil
+}
+
+// load fetches the current page, moving to the last page if the current one no longer exists, and re-renders the
+// table. On error, the previous rows are kept.
+func (dt *DataTable) load() {
+	for {
+		rows, total, err := dt.loadPage(dt.page, dt.options.PageSize, SortSpec{Col: dt.sortCol, Desc: dt.sortDesc})
+		dt.loadErr = err
+		if err != nil {
+			break
+		}
+
+		dt.total = total
+		dt.all = make([]reflect.Value, len(rows))
+		for i, row := range rows {
+			dt.all[i] = reflect.ValueOf(row)
+		}
+		if last := dt.PageCount() - 1; dt.page > last {
+			dt.page = last
+			continue
+		}
+		break
+	}
+	dt.update()
+}
+
+// Reload fetches the current page of a table made with MakePagedDataTable again, for example after the data changed
+// in the backend, and re-renders the table. The error of the LoadPageFunc is returned. Mark the table dirty after
+// calling this from an event handler.
+func (dt *DataTable) Reload() error {
+	if dt.loadPage == nil {
+		return nil
+	}
+	dt.load()
+	return dt.loadErr
+}
+
+// LoadError returns the error of the last LoadPageFunc call of a table made with MakePagedDataTable, nil if it
+// succeeded.
+func (dt *DataTable) LoadError() error {
+	return dt.loadErr
+}
+
+// Total returns the total number of rows of a table made with MakePagedDataTable as returned by the LoadPageFunc, or
+// Len for other tables.
+func (dt *DataTable) Total() int {
+	if dt.loadPage == nil {
+		return dt.Len()
+	}
+	return dt.total
+}
+
+// renderLoadError adds a row spanning all columns with the load error.
+func (dt *DataTable) renderLoadError(row int) {
+	dt.EnsureSize(row+1, len(dt.columns))
+	dt.Add(dt.g.MakeLabel("Could not load page: "+dt.loadErr.Error(), Options{Color: gwu.ClrRed}), row, 0)
+	dt.SetColSpan(row, 0, len(dt.columns))
+}
@@ -0,0 +1,103 @@
+package wgowut
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBackend serves the rows {"host<i>", "<i>"} page by page.
+type testBackend struct {
+	total int
+	err   error
+	calls []SortSpec
+}
+
+func (b *testBackend) loadPage(page, size int, spec SortSpec) ([][]string, int, error) {
+	b.calls = append(b.calls, spec)
+	if b.err != nil {
+		return nil, 0, b.err
+	}
+
+	var rows [][]string
+	for i := 0; i < b.total; i++ {
+		rows = append(rows, []string{"host" + strconv.Itoa(i), strconv.Itoa(i)})
+	}
+	if spec.Desc {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+	start, end := page*size, (page+1)*size
+	if end > len(rows) {
+		end = len(rows)
+	}
+	if start > end {
+		start = end
+	}
+	rows = rows[start:end]
+	if page == 0 && !spec.Desc && len(rows) != 0 {
+		rows[0] = rows[0][:1] // short rows are padded
+	}
+	return rows, b.total, nil
+}
+
+func TestGuiBuilder_MakePagedDataTable(t *testing.T) {
+	b := &testBackend{total: 5}
+	g := &GuiBuilder{}
+	dt, err := g.MakePagedDataTable([]string{"Host", "Id"}, b.loadPage, Options{PageSize: 2, Sortable: true})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []SortSpec{{Col: -1}}, b.calls)
+	assert.Equal(t, 2, dt.Len())
+	assert.Equal(t, 5, dt.Total())
+	assert.Equal(t, 3, dt.PageCount())
+	assert.Equal(t, [][]string{{"host0", ""}, {"host1", "1"}}, dt.Values())
+	assert.Equal(t, "Page 1 of 3", dt.CompAt(3, 0).(gwu.Panel).CompAt(2).(gwu.Label).Text())
+
+	dt.SetPage(2)
+	assert.Equal(t, [][]string{{"host4", "4"}}, dt.Values())
+
+	// sorting loads the page again
+	dt.sortHandler(1)(&testEvent{})
+	dt.sortHandler(1)(&testEvent{})
+	assert.Equal(t, SortSpec{Col: 1, Desc: true}, b.calls[len(b.calls)-1])
+	assert.Equal(t, [][]string{{"host0", "0"}}, dt.Values())
+	assert.Equal(t, "Id"+SortDescIndicator, dt.CompAt(0, 1).(gwu.Label).Text())
+
+	// errors keep the rows and their page
+	b.err = errors.New("backend down")
+	dt.SetPage(0)
+	assert.Equal(t, b.err, dt.LoadError())
+	assert.Equal(t, [][]string{{"host0", "0"}}, dt.Values())
+	assert.Equal(t, 2, dt.Page())
+	assert.Equal(t, "Could not load page: backend down", dt.CompAt(2, 0).(gwu.Label).Text())
+	assert.Equal(t, "Page 3 of 3", dt.CompAt(3, 0).(gwu.Panel).CompAt(2).(gwu.Label).Text())
+
+	// a shrinking total moves to the last page
+	b.err = nil
+	dt.SetPage(2)
+	b.total = 3
+	assert.NoError(t, dt.Reload())
+	assert.Equal(t, 1, dt.Page())
+	assert.Equal(t, 2, dt.PageCount())
+	assert.Nil(t, dt.LoadError())
+
+	assert.Equal(t, ErrNotCSVTable, dt.ReloadCSV(nil))
+
+	b.err = errors.New("backend down")
+	_, err = g.MakePagedDataTable([]string{"Host"}, b.loadPage, Options{})
+	assert.Equal(t, b.err, err)
+
+	// the page size defaults
+	b.err, b.calls = nil, nil
+	dt, err = g.MakePagedDataTable([]string{"Host"}, func(page, size int, sort SortSpec) ([][]string, int, error) {
+		assert.Equal(t, DefaultPageSize, size)
+		return nil, 0, nil
+	}, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dt.PageCount())
+}
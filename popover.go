@@ -0,0 +1,58 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// AttachPopover makes content pop up in a floating panel next to target while the mouse is over target, and toggles
+// it when target is clicked, for help and previews that plain title tooltips can't format. If target is in a panel,
+// the popover is inserted after it; otherwise the returned popover has to be added next to target. The popover is
+// positioned absolutely where it would flow, so it appears below or after target without moving other components.
+func (g *GuiBuilder) AttachPopover(target gwu.Comp, content gwu.Comp) gwu.Panel {
+	popover := g.MakePanel(Options{Layout: LayoutVertical, CellPadding: 6, Background: gwu.ClrWhite, BorderWidth: 1,
+		BorderStyle: gwu.BrdStyleSolid, BorderColor: gwu.ClrSilver})
+	popover.Style().Set("position", "absolute").Set("z-index", "1000").Set("box-shadow", "0 2px 8px rgba(0,0,0,0.25)").
+		Set("border-radius", "4px").SetDisplay(gwu.DisplayNone)
+	popover.SetAttr("role", "tooltip")
+	target.SetAttr("aria-describedby", popover.ID().String())
+	if content != nil {
+		popover.Add(content)
+	}
+
+	target.AddEHandlerFunc(popoverHandler(popover), gwu.ETypeMouseOver, gwu.ETypeMouseOut, gwu.ETypeClick)
+
+	if parent, ok := target.Parent().(gwu.Panel); ok {
+		if idx := parent.CompIdx(target); idx >= 0 {
+			parent.Insert(popover, idx+1)
+		}
+	}
+
+	return popover
+}
+
+// popoverHandler returns the handler of the target of popover showing it on mouse over, hiding it on mouse out and
+// toggling it on click.
+func popoverHandler(popover gwu.Panel) func(gwu.Event) {
+	return func(e gwu.Event) {
+		shown := popover.Style().Display() != gwu.DisplayNone
+		show := shown
+		switch e.Type() {
+		case gwu.ETypeMouseOver:
+			show = true
+		case gwu.ETypeMouseOut:
+			show = false
+		case gwu.ETypeClick:
+			show = !shown
+		}
+		if show == shown {
+			return
+		}
+
+		if show {
+			popover.Style().SetDisplay("")
+		} else {
+			popover.Style().SetDisplay(gwu.DisplayNone)
+		}
+		e.MarkDirty(popover)
+	}
+}
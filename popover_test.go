@@ -0,0 +1,53 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_AttachPopover(t *testing.T) {
+	g := &GuiBuilder{}
+	panel := g.MakePanel(Options{Layout: LayoutHorizontal})
+	target, other := g.MakeLabel("CPU", Options{}), g.MakeLabel("Memory", Options{})
+	g.AddCompsToPanel(panel, target, other)
+	content := g.MakeKeyValueTable([]KV{{"Cores", "8"}, {"Load", "0.42"}}, Options{Bold: true}, Options{})
+
+	popover := g.AttachPopover(target, content)
+	assert.Equal(t, 3, panel.CompsCount())
+	assert.Equal(t, gwu.Comp(popover), panel.CompAt(1))
+	assert.Equal(t, gwu.Comp(content), popover.CompAt(0))
+	assert.Equal(t, gwu.DisplayNone, popover.Style().Display())
+	assert.Equal(t, "absolute", popover.Style().Get("position"))
+	assert.Equal(t, popover.ID().String(), target.Attr("aria-describedby"))
+	for _, etype := range []gwu.EventType{gwu.ETypeMouseOver, gwu.ETypeMouseOut, gwu.ETypeClick} {
+		assert.Equal(t, 1, target.HandlersCount(etype))
+	}
+
+	handler := popoverHandler(popover)
+	events := []struct {
+		etype gwu.EventType
+		shown bool
+		dirty bool
+	}{
+		{gwu.ETypeMouseOver, true, true},
+		{gwu.ETypeMouseOver, true, false},
+		{gwu.ETypeMouseOut, false, true},
+		{gwu.ETypeClick, true, true},
+		{gwu.ETypeClick, false, true},
+		{gwu.ETypeMouseOut, false, false},
+	}
+	for i, ev := range events {
+		e := &testEvent{etype: ev.etype, src: target}
+		handler(e)
+		assert.Equal(t, ev.shown, popover.Style().Display() != gwu.DisplayNone, "event %d", i)
+		assert.Equal(t, ev.dirty, len(e.dirty) == 1, "event %d", i)
+	}
+
+	// without a panel parent the popover is only returned
+	lone := g.MakeButton("Help", Options{})
+	popover = g.AttachPopover(lone, nil)
+	assert.Nil(t, popover.Parent())
+	assert.Equal(t, 0, popover.CompsCount())
+}
@@ -0,0 +1,103 @@
+package wgowut
+
+import (
+	"strconv"
+
+	"github.com/icza/gowut/gwu"
+)
+
+const (
+	// ProgressBarHeight is the default height of progress bars made by MakeProgressBar.
+	ProgressBarHeight = "20px"
+	// ProgressBarColor is the default color of the filled part of progress bars.
+	ProgressBarColor = "#4A90D9"
+)
+
+// ProgressBar displays the progress of a job as a partly filled bar with a label over it. Create it with
+// MakeProgressBar.
+type ProgressBar struct {
+	gwu.Panel
+	fill    gwu.Panel
+	label   gwu.Label
+	percent float64
+	text    string // text is the label set with SetLabel
+}
+
+// MakeProgressBar creates a progress bar at 0%, made of nested panels so it needs no JavaScript. The track is made
+// with MakePanel using options with LayoutVertical; Width defaults to FullWidth, Height to ProgressBarHeight and
+// Background to ClrSilver. Color is the color of the filled part, ProgressBarColor by default.
+func (g *GuiBuilder) MakeProgressBar(options Options) *ProgressBar {
+	fillColor := options.Color
+	if fillColor == "" {
+		fillColor = ProgressBarColor
+	}
+	options.Layout, options.CellPadding, options.Color = LayoutVertical, 0, ""
+	if options.Width == "" {
+		options.Width = FullWidth
+	}
+	if options.Height == "" {
+		options.Height = ProgressBarHeight
+	}
+	if options.Background == "" {
+		options.Background = gwu.ClrSilver
+	}
+
+	pb := &ProgressBar{Panel: g.MakePanel(options)}
+	pb.Style().Set("position", "relative").Set("border-radius", "3px")
+	pb.SetAttr("role", "progressbar")
+	pb.SetAttr("aria-valuemin", "0")
+	pb.SetAttr("aria-valuemax", "100")
+
+	pb.fill = g.MakePanel(Options{Layout: LayoutVertical, Background: fillColor, Height: options.Height})
+	pb.label = g.MakeLabel("", Options{WhiteSpace: gwu.WhiteSpaceNowrap})
+	pb.label.Style().Set("position", "absolute").Set("top", "0").Set("left", "0").SetWidth("100%").
+		Set("text-align", "center").Set("line-height", options.Height)
+	g.AddCompsToPanel(pb, pb.fill, pb.label)
+	pb.CellFmt(pb.fill).SetHAlign(gwu.HALeft)
+
+	pb.SetPercent(0)
+	return pb
+}
+
+// SetPercent sets the progress in percent, clamped to 0..100. The label displays the percentage unless set with
+// SetLabel. Mark the progress bar dirty after calling this from an event handler.
+func (pb *ProgressBar) SetPercent(percent float64) {
+	if percent < 0 {
+		percent = 0
+	} else if percent > 100 {
+		percent = 100
+	}
+	pb.percent = percent
+
+	value := strconv.FormatFloat(percent, 'f', -1, 64)
+	pb.fill.Style().SetWidth(value + "%")
+	pb.SetAttr("aria-valuenow", value)
+	pb.updateLabel()
+}
+
+// Percent returns the progress in percent.
+func (pb *ProgressBar) Percent() float64 {
+	return pb.percent
+}
+
+// SetLabel sets the text displayed over the bar, like "Copying 3 of 10 files"; the percentage is displayed if label
+// is empty. Mark the progress bar dirty after calling this from an event handler.
+func (pb *ProgressBar) SetLabel(label string) {
+	pb.text = label
+	pb.SetAttr("aria-valuetext", label)
+	pb.updateLabel()
+}
+
+// Label returns the text set with SetLabel.
+func (pb *ProgressBar) Label() string {
+	return pb.text
+}
+
+// updateLabel displays the label set with SetLabel, or the percentage.
+func (pb *ProgressBar) updateLabel() {
+	if pb.text != "" {
+		pb.label.SetText(pb.text)
+	} else {
+		pb.label.SetText(strconv.Itoa(int(pb.percent)) + "%")
+	}
+}
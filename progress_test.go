@@ -0,0 +1,48 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeProgressBar(t *testing.T) {
+	g := &GuiBuilder{}
+	pb := g.MakeProgressBar(Options{})
+
+	assert.Equal(t, "100%", pb.Style().Width())
+	assert.Equal(t, ProgressBarHeight, pb.Style().Height())
+	assert.Equal(t, gwu.ClrSilver, pb.Style().Background())
+	assert.Equal(t, "progressbar", pb.Attr("role"))
+	assert.Equal(t, ProgressBarColor, pb.fill.Style().Background())
+	assert.Equal(t, 0.0, pb.Percent())
+	assert.Equal(t, "0%", pb.fill.Style().Width())
+	assert.Equal(t, "0%", pb.label.Text())
+
+	pb.SetPercent(42.5)
+	assert.Equal(t, 42.5, pb.Percent())
+	assert.Equal(t, "42.5%", pb.fill.Style().Width())
+	assert.Equal(t, "42.5", pb.Attr("aria-valuenow"))
+	assert.Equal(t, "42%", pb.label.Text())
+
+	pb.SetLabel("Copying 4 of 10 files")
+	assert.Equal(t, "Copying 4 of 10 files", pb.Label())
+	assert.Equal(t, "Copying 4 of 10 files", pb.label.Text())
+	pb.SetPercent(50)
+	assert.Equal(t, "Copying 4 of 10 files", pb.label.Text())
+	pb.SetLabel("")
+	assert.Equal(t, "50%", pb.label.Text())
+
+	pb.SetPercent(150)
+	assert.Equal(t, 100.0, pb.Percent())
+	pb.SetPercent(-1)
+	assert.Equal(t, 0.0, pb.Percent())
+
+	pb = g.MakeProgressBar(Options{Width: "300px", Height: "10px", Color: gwu.ClrGreen, Background: gwu.ClrWhite})
+	assert.Equal(t, "300px", pb.Style().Width())
+	assert.Equal(t, "", pb.Style().Color())
+	assert.Equal(t, gwu.ClrWhite, pb.Style().Background())
+	assert.Equal(t, gwu.ClrGreen, pb.fill.Style().Background())
+	assert.Equal(t, "10px", pb.fill.Style().Height())
+}
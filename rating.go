@@ -0,0 +1,118 @@
+package wgowut
+
+import (
+	"strconv"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// Rating defaults used by MakeRating.
+const (
+	RatingColor      = "#FFB400"     // RatingColor is the color of the selected stars of ratings.
+	RatingEmptyColor = gwu.ClrSilver // RatingEmptyColor is the color of the unselected stars of ratings.
+)
+
+// Rating is a row of clickable stars selecting a value from 0 to a maximum. Create it with MakeRating.
+type Rating struct {
+	gwu.Panel
+	stars    []gwu.Label
+	color    string
+	value    int
+	readOnly bool
+	onChange func(e gwu.Event, value int)
+}
+
+// MakeRating creates a rating of max stars (at least 1) set to initial, like in feedback forms. Clicking a star
+// selects it and the stars before it. The rating is read-only if the ReadOnly option is set or Enable is EnableFalse;
+// read-only ratings only display their value. Color and FontSize style the selected stars (RatingColor and "150%" by
+// default). The panel of the stars is made with MakePanel using options with LayoutHorizontal.
+func (g *GuiBuilder) MakeRating(max, initial int, options Options) *Rating {
+	if max < 1 {
+		max = 1
+	}
+	color := options.Color
+	if color == "" {
+		color = RatingColor
+	}
+	if options.FontSize == "" {
+		options.FontSize = "150%"
+	}
+	options.Layout = LayoutHorizontal
+	options.Color = ""
+
+	r := &Rating{color: color, readOnly: options.ReadOnly || options.Enable == EnableFalse}
+	r.Panel = g.MakePanel(options)
+	for i := 0; i < max; i++ {
+		star := gwu.NewLabel("★")
+		star.Style().SetWhiteSpace(gwu.WhiteSpaceNowrap)
+		star.SetAttr("title", strconv.Itoa(i+1))
+		star.AddEHandlerFunc(r.clickHandler(i+1), gwu.ETypeClick)
+		r.stars = append(r.stars, star)
+		r.Add(star)
+	}
+	r.SetReadOnly(r.readOnly)
+	r.SetValue(initial)
+
+	return r
+}
+
+// Value returns the number of selected stars.
+func (r *Rating) Value() int {
+	return r.value
+}
+
+// SetValue sets the number of selected stars, clamped to the range of the rating. Mark the rating dirty after calling
+// this from an event handler.
+func (r *Rating) SetValue(value int) {
+	if value < 0 {
+		value = 0
+	}
+	if value > len(r.stars) {
+		value = len(r.stars)
+	}
+	r.value = value
+	for i, star := range r.stars {
+		if i < value {
+			star.Style().SetColor(r.color)
+		} else {
+			star.Style().SetColor(RatingEmptyColor)
+		}
+	}
+}
+
+// ReadOnly tells if the rating is read-only.
+func (r *Rating) ReadOnly() bool {
+	return r.readOnly
+}
+
+// SetReadOnly sets if the rating is read-only, ignoring clicks. Mark the rating dirty after calling this from an
+// event handler.
+func (r *Rating) SetReadOnly(readOnly bool) {
+	r.readOnly = readOnly
+	cursor := "pointer"
+	if readOnly {
+		cursor = "default"
+	}
+	for _, star := range r.stars {
+		star.Style().Set("cursor", cursor)
+	}
+}
+
+// OnChange sets the function called with the new value after the user clicks a star.
+func (r *Rating) OnChange(fn func(e gwu.Event, value int)) {
+	r.onChange = fn
+}
+
+// clickHandler returns the click handler of the star selecting value.
+func (r *Rating) clickHandler(value int) func(gwu.Event) {
+	return func(e gwu.Event) {
+		if r.readOnly || value == r.value {
+			return
+		}
+		r.SetValue(value)
+		e.MarkDirty(r)
+		if r.onChange != nil {
+			r.onChange(e, value)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+// ratingColors returns the colors of the stars of r.
+func ratingColors(r *Rating) (colors []string) {
+	for _, star := range r.stars {
+		colors = append(colors, star.Style().Color())
+	}
+	return
+}
+
+func TestGuiBuilder_MakeRating(t *testing.T) {
+	g := &GuiBuilder{}
+	r := g.MakeRating(5, 3, Options{CellPadding: 1})
+
+	assert.Equal(t, gwu.LayoutHorizontal, r.Layout())
+	assert.Equal(t, 1, r.CellPadding())
+	assert.Equal(t, 5, r.CompsCount())
+	assert.Equal(t, "150%", r.Style().FontSize())
+	assert.Equal(t, "", r.Style().Color())
+	assert.Equal(t, "★", r.stars[0].Text())
+	assert.Equal(t, "2", r.stars[1].Attr("title"))
+	assert.Equal(t, "pointer", r.stars[0].Style().Get("cursor"))
+	assert.Equal(t, 3, r.Value())
+	assert.False(t, r.ReadOnly())
+	assert.Equal(t, []string{RatingColor, RatingColor, RatingColor, RatingEmptyColor, RatingEmptyColor}, ratingColors(r))
+
+	var changes []int
+	r.OnChange(func(e gwu.Event, value int) { changes = append(changes, value) })
+	e := &testEvent{etype: gwu.ETypeClick, src: r.stars[4]}
+	r.clickHandler(5)(e)
+	assert.Equal(t, 5, r.Value())
+	assert.Equal(t, []gwu.Comp{r}, e.dirty)
+	r.clickHandler(5)(&testEvent{etype: gwu.ETypeClick})
+	r.clickHandler(1)(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, []int{5, 1}, changes)
+	assert.Equal(t, []string{RatingColor, RatingEmptyColor, RatingEmptyColor, RatingEmptyColor, RatingEmptyColor}, ratingColors(r))
+
+	r.SetValue(9)
+	assert.Equal(t, 5, r.Value())
+	r.SetValue(-1)
+	assert.Equal(t, 0, r.Value())
+
+	r.SetReadOnly(true)
+	r.clickHandler(2)(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, 0, r.Value())
+	assert.Equal(t, "default", r.stars[0].Style().Get("cursor"))
+	assert.Equal(t, []int{5, 1}, changes)
+}
+
+func TestGuiBuilder_MakeRating_ReadOnly(t *testing.T) {
+	g := &GuiBuilder{}
+	for _, options := range []Options{{ReadOnly: true}, {Enable: EnableFalse}} {
+		r := g.MakeRating(3, 2, options)
+		assert.True(t, r.ReadOnly())
+		r.clickHandler(3)(&testEvent{etype: gwu.ETypeClick})
+		assert.Equal(t, 2, r.Value())
+	}
+
+	r := g.MakeRating(0, 1, Options{Color: gwu.ClrRed, FontSize: "12px"})
+	assert.Equal(t, 1, r.CompsCount())
+	assert.Equal(t, "12px", r.Style().FontSize())
+	assert.Equal(t, []string{gwu.ClrRed}, ratingColors(r))
+}
@@ -0,0 +1,219 @@
+package wgowut
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// RecordedEvent is an event captured by an EventRecorder. It only holds plain values so recordings can be stored (for
+// example with encoding/json) and replayed later.
+type RecordedEvent struct {
+	Path     string        // Path of the source component from the root of its tree, child indexes joined with "/"
+	Type     gwu.EventType // Type of the event
+	Value    string        // Value of the source component when the event happened, see Form.Values; empty for list boxes
+	Selected []string      // Selected values of list boxes when the event happened, which may contain commas
+	Key      gwu.Key       // Key code of key events
+}
+
+// EventRecorder captures the sequence of events handled by the handlers added through it, and replays them against a
+// freshly built window, which helps reproducing bugs in tests. A recorder is meant to be used by a single session:
+// gwu serializes the events of a session, so create a recorder per session.
+type EventRecorder struct {
+	mu        sync.Mutex
+	recording bool
+	events    []RecordedEvent
+	handlers  map[gwu.ID]map[gwu.EventType][]func(gwu.Event)
+}
+
+// NewEventRecorder returns an EventRecorder that is not recording.
+func NewEventRecorder() *EventRecorder {
+	return &EventRecorder{handlers: make(map[gwu.ID]map[gwu.EventType][]func(gwu.Event))}
+}
+
+// AddEHandlerFunc adds fn to comp as the handler of the event types like comp.AddEHandlerFunc does, and records the
+// events it handles while the recorder is recording. The handlers of a window must be added through the recorder for
+// Replay to find them.
+func (r *EventRecorder) AddEHandlerFunc(comp gwu.Comp, fn func(gwu.Event), etypes ...gwu.EventType) {
+	r.mu.Lock()
+	byType := r.handlers[comp.ID()]
+	if byType == nil {
+		byType = make(map[gwu.EventType][]func(gwu.Event))
+		r.handlers[comp.ID()] = byType
+	}
+	for _, etype := range etypes {
+		byType[etype] = append(byType[etype], fn)
+	}
+	r.mu.Unlock()
+
+	comp.AddEHandlerFunc(r.recordHandler(fn), etypes...)
+}
+
+func (r *EventRecorder) recordHandler(fn func(gwu.Event)) func(gwu.Event) {
+	return func(e gwu.Event) {
+		r.record(e)
+		fn(e)
+	}
+}
+
+func (r *EventRecorder) record(e gwu.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.recording {
+		return
+	}
+	src := e.Src()
+	re := RecordedEvent{Path: compPath(src), Type: e.Type(), Key: e.KeyCode()}
+	if lb, ok := src.(gwu.ListBox); ok {
+		re.Selected = lb.SelectedValues()
+	} else {
+		re.Value = fieldValue(src)
+	}
+	r.events = append(r.events, re)
+}
+
+// Start discards the events recorded so far and starts recording.
+func (r *EventRecorder) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = nil
+	r.recording = true
+}
+
+// Stop stops recording and returns the recorded events.
+func (r *EventRecorder) Stop() []RecordedEvent {
+	r.mu.Lock()
+	r.recording = false
+	r.mu.Unlock()
+
+	return r.Events()
+}
+
+// Events returns a copy of the events recorded so far.
+func (r *EventRecorder) Events() []RecordedEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]RecordedEvent(nil), r.events...)
+}
+
+// Replay replays events against win, which must have been built the same way as the recorded window with its handlers
+// added through r. For each event the source component is looked up by path, its recorded value is restored (text of
+// text boxes, state of check boxes, selection of list boxes) and the handlers added for the event type are called with
+// an event of sess. Replaying is not recorded. An error is returned if a component or handler of an event can't be
+// found; the events before it have been replayed.
+func (r *EventRecorder) Replay(win gwu.Window, sess gwu.Session, events []RecordedEvent) error {
+	for i, re := range events {
+		comp := compAtPath(win, re.Path)
+		if comp == nil {
+			return fmt.Errorf("wgowut: event %d: no component at path %q", i, re.Path)
+		}
+
+		r.mu.Lock()
+		handlers := r.handlers[comp.ID()][re.Type]
+		r.mu.Unlock()
+		if len(handlers) == 0 {
+			return fmt.Errorf("wgowut: event %d: no handler for event type %d of component at path %q", i, re.Type, re.Path)
+		}
+
+		restoreValue(comp, re)
+		e := &replayEvent{etype: re.Type, src: comp, key: re.Key, sess: sess}
+		for _, handler := range handlers {
+			handler(e)
+		}
+	}
+	return nil
+}
+
+// compPath returns the path of comp from the root of its tree, see RecordedEvent. The path is searched from the root
+// down as the parent of a tab content is not the tab panel but the panel it embeds.
+func compPath(comp gwu.Comp) string {
+	root := comp
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	idxs, _ := findCompPath(root, comp)
+	return strings.Join(idxs, "/")
+}
+
+// findCompPath returns the child indexes leading from root to comp, and if comp was found.
+func findCompPath(root, comp gwu.Comp) ([]string, bool) {
+	if root == comp {
+		return nil, true
+	}
+	for i, child := range childComps(root) {
+		if idxs, ok := findCompPath(child, comp); ok {
+			return append([]string{strconv.Itoa(i)}, idxs...), true
+		}
+	}
+	return nil, false
+}
+
+// compAtPath returns the component at path in the tree of root, or nil if there is none.
+func compAtPath(root gwu.Comp, path string) gwu.Comp {
+	if path == "" {
+		return root
+	}
+	comp := root
+	for _, part := range strings.Split(path, "/") {
+		idx, err := strconv.Atoi(part)
+		children := childComps(comp)
+		if err != nil || idx < 0 || idx >= len(children) {
+			return nil
+		}
+		comp = children[idx]
+	}
+	return comp
+}
+
+// restoreValue sets the value of the input recorded in re.
+func restoreValue(input gwu.Comp, re RecordedEvent) {
+	switch in := input.(type) {
+	case gwu.CheckBox:
+		in.SetState(re.Value == "true")
+	case gwu.ListBox:
+		in.ClearSelected()
+		selected := make(map[string]bool)
+		for _, v := range re.Selected {
+			selected[v] = true
+		}
+		for i, v := range in.Values() {
+			if selected[v] {
+				in.SetSelected(i, true)
+			}
+		}
+	case gwu.TextBox:
+		in.SetText(re.Value)
+	}
+}
+
+// replayEvent is the event passed to handlers by Replay. There is no browser to update, so MarkDirty, SetFocusedComp
+// and ReloadWin do nothing.
+type replayEvent struct {
+	gwu.Event
+	etype gwu.EventType
+	src   gwu.Comp
+	key   gwu.Key
+	sess  gwu.Session
+}
+
+func (e *replayEvent) Type() gwu.EventType     { return e.etype }
+func (e *replayEvent) Src() gwu.Comp           { return e.src }
+func (e *replayEvent) Parent() gwu.Event       { return nil }
+func (e *replayEvent) Mouse() (int, int)       { return -1, -1 }
+func (e *replayEvent) MouseWin() (int, int)    { return -1, -1 }
+func (e *replayEvent) MouseBtn() gwu.MouseBtn  { return gwu.MouseBtnUnknown }
+func (e *replayEvent) ModKeys() int            { return 0 }
+func (e *replayEvent) ModKey(gwu.ModKey) bool  { return false }
+func (e *replayEvent) KeyCode() gwu.Key        { return e.key }
+func (e *replayEvent) MarkDirty(...gwu.Comp)   {}
+func (e *replayEvent) SetFocusedComp(gwu.Comp) {}
+func (e *replayEvent) ReloadWin(string)        {}
+func (e *replayEvent) Session() gwu.Session    { return e.sess }
+func (e *replayEvent) NewSession() gwu.Session { return e.sess }
+func (e *replayEvent) RemoveSess()             {}
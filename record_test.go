@@ -0,0 +1,136 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordWindow builds a window whose handlers are added through r and log the values they see.
+func recordWindow(g *GuiBuilder, r *EventRecorder, log *[]string) (gwu.Window, gwu.TextBox, gwu.CheckBox, gwu.ListBox, gwu.Button) {
+	win := gwu.NewWindow("main", "Main")
+	tb := g.MakeTextBox("", Options{})
+	cb := gwu.NewCheckBox("enabled")
+	lb := g.MakeListBox([]string{"admin", "user", "guest"}, Options{})
+	lb.SetMulti(true)
+	btn := g.MakeButton("Save", Options{})
+
+	inner := gwu.NewHorizontalPanel()
+	inner.Add(cb)
+	inner.Add(lb)
+	win.Add(tb)
+	win.Add(inner)
+	win.Add(btn)
+
+	r.AddEHandlerFunc(tb, func(e gwu.Event) {
+		*log = append(*log, "text "+e.Src().(gwu.TextBox).Text())
+	}, gwu.ETypeChange, gwu.ETypeKeyUp)
+	r.AddEHandlerFunc(cb, func(e gwu.Event) {
+		*log = append(*log, "check "+fieldValue(e.Src()))
+	}, gwu.ETypeClick)
+	r.AddEHandlerFunc(lb, func(e gwu.Event) {
+		*log = append(*log, "list "+fieldValue(e.Src()))
+	}, gwu.ETypeChange)
+	r.AddEHandlerFunc(btn, func(e gwu.Event) {
+		*log = append(*log, "save "+tb.Text())
+	}, gwu.ETypeClick)
+
+	return win, tb, cb, lb, btn
+}
+
+// fire simulates a browser event of etype on comp, calling the handlers added through r the way gwu would.
+func fire(r *EventRecorder, comp gwu.Comp, etype gwu.EventType, key gwu.Key) {
+	e := &testEvent{etype: etype, src: comp, key: key}
+	for _, fn := range r.handlers[comp.ID()][etype] {
+		r.recordHandler(fn)(e)
+	}
+}
+
+func TestEventRecorder(t *testing.T) {
+	g := &GuiBuilder{}
+	r := NewEventRecorder()
+	var log []string
+	win, tb, cb, lb, btn := recordWindow(g, r, &log)
+
+	// events are only recorded while recording
+	fire(r, btn, gwu.ETypeClick, 0)
+	r.Start()
+	tb.SetText("bob")
+	fire(r, tb, gwu.ETypeKeyUp, gwu.KeyEnter)
+	cb.SetState(true)
+	fire(r, cb, gwu.ETypeClick, 0)
+	lb.SetSelectedIndices([]int{0, 2})
+	fire(r, lb, gwu.ETypeChange, 0)
+	fire(r, btn, gwu.ETypeClick, 0)
+	events := r.Stop()
+	fire(r, btn, gwu.ETypeClick, 0)
+
+	assert.Equal(t, []RecordedEvent{
+		{Path: "0", Type: gwu.ETypeKeyUp, Value: "bob", Key: gwu.KeyEnter},
+		{Path: "1/0", Type: gwu.ETypeClick, Value: "true"},
+		{Path: "1/1", Type: gwu.ETypeChange, Selected: []string{"admin", "guest"}},
+		{Path: "2", Type: gwu.ETypeClick},
+	}, events)
+	assert.Equal(t, events, r.Events())
+	assert.Equal(t, []string{"save ", "text bob", "check true", "list admin,guest", "save bob", "save bob"}, log)
+	assert.Equal(t, "", compPath(win))
+
+	// tab contents have the embedded panel of the tab panel as parent
+	tabs := gwu.NewTabPanel()
+	content := gwu.NewLabel("content")
+	tabs.AddString("first", gwu.NewLabel("first"))
+	tabs.AddString("second", content)
+	win.Add(tabs)
+	assert.Equal(t, "3/3", compPath(content))
+	assert.Equal(t, content, compAtPath(win, "3/3"))
+
+	// replaying against a fresh window restores the values and calls the handlers
+	replayer := NewEventRecorder()
+	var replayLog []string
+	win, tb, cb, lb, _ = recordWindow(g, replayer, &replayLog)
+	sess := newTestSession("s")
+	replayer.Start()
+	assert.NoError(t, replayer.Replay(win, sess, events))
+	assert.Equal(t, []string{"text bob", "check true", "list admin,guest", "save bob"}, replayLog)
+	assert.Equal(t, "bob", tb.Text())
+	assert.True(t, cb.State())
+	assert.Equal(t, []string{"admin", "guest"}, lb.SelectedValues())
+	assert.Empty(t, replayer.Stop())
+
+	// replaying a changed window fails at the first event not found
+	for _, test := range []struct {
+		event RecordedEvent
+		err   string
+	}{
+		{RecordedEvent{Path: "5", Type: gwu.ETypeClick}, `wgowut: event 1: no component at path "5"`},
+		{RecordedEvent{Path: "x", Type: gwu.ETypeClick}, `wgowut: event 1: no component at path "x"`},
+		{RecordedEvent{Path: "1", Type: gwu.ETypeClick}, `wgowut: event 1: no handler for event type 0 of component at path "1"`},
+	} {
+		replayLog = nil
+		err := replayer.Replay(win, sess, []RecordedEvent{events[3], test.event})
+		assert.EqualError(t, err, test.err)
+		assert.Equal(t, []string{"save bob"}, replayLog)
+	}
+}
+
+func TestEventRecorder_listBoxCommas(t *testing.T) {
+	g := &GuiBuilder{}
+	r := NewEventRecorder()
+	win := g.MakeWindow("main", "Main", Options{})
+	lb := g.MakeListBox([]string{"Smith, John", "Doe, Jane", "John"}, Options{Multi: true})
+	win.Add(lb)
+	var got []string
+	r.AddEHandlerFunc(lb, func(e gwu.Event) { got = lb.SelectedValues() }, gwu.ETypeChange)
+
+	r.Start()
+	lb.SetSelectedIndices([]int{0, 1})
+	fire(r, lb, gwu.ETypeChange, 0)
+	events := r.Stop()
+	assert.Equal(t, []string{"Smith, John", "Doe, Jane"}, events[0].Selected)
+
+	// values with commas are restored as they were, not split
+	lb.ClearSelected()
+	assert.NoError(t, r.Replay(win, newTestSession("s"), events))
+	assert.Equal(t, []string{"Smith, John", "Doe, Jane"}, got)
+}
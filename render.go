@@ -0,0 +1,51 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// OnRender registers fn to be called by the wgowut refresh helpers of g (GuiBuilder.Refresh, and the refreshes of the
+// binders, live registries and auto refreshers made by g) just before comp, or one of its ancestors, is marked dirty.
+// This allows last moment data pulls, like refreshing a label from a cache whenever it is re-rendered. Multiple
+// functions are called in the order they were registered. Passing a nil fn removes all functions registered for comp;
+// ForgetTree removes them too, so they don't outlive discarded components.
+func (g *GuiBuilder) OnRender(comp gwu.Comp, fn func()) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if fn == nil {
+		delete(g.hooks, comp.ID())
+		return
+	}
+	if g.hooks == nil {
+		g.hooks = make(map[gwu.ID][]func())
+	}
+	g.hooks[comp.ID()] = append(g.hooks[comp.ID()], fn)
+}
+
+// Refresh calls the OnRender functions of the components and all of their descendants, then marks the components
+// dirty.
+func (g *GuiBuilder) Refresh(e gwu.Event, comps ...gwu.Comp) {
+	for _, comp := range comps {
+		g.runRenderHooks(comp)
+	}
+	e.MarkDirty(comps...)
+}
+
+// runRenderHooks calls the OnRender functions registered for comp and its descendants.
+func (g *GuiBuilder) runRenderHooks(comp gwu.Comp) {
+	g.mu.Lock()
+	if len(g.hooks) == 0 {
+		g.mu.Unlock()
+		return
+	}
+	var fns []func()
+	walkComps(comp, func(c gwu.Comp) {
+		fns = append(fns, g.hooks[c.ID()]...)
+	})
+	g.mu.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
+}
@@ -0,0 +1,74 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_OnRender(t *testing.T) {
+	g := &GuiBuilder{}
+	panel := g.MakePanel(Options{})
+	label := g.MakeLabel("stale", Options{})
+	other := g.MakeLabel("other", Options{})
+	panel.Add(label)
+
+	var calls []string
+	g.OnRender(label, func() { label.SetText("fresh"); calls = append(calls, "label 1") })
+	g.OnRender(label, func() { calls = append(calls, "label 2") })
+	g.OnRender(panel, func() { calls = append(calls, "panel") })
+	g.OnRender(other, func() { calls = append(calls, "other") })
+
+	// hooks of descendants run when the parent is refreshed
+	e := &testEvent{}
+	g.Refresh(e, panel)
+	assert.Equal(t, []string{"panel", "label 1", "label 2"}, calls)
+	assert.Equal(t, "fresh", label.Text())
+	assert.Equal(t, []gwu.Comp{panel}, e.dirty)
+
+	// nil removes the hooks
+	calls = nil
+	g.OnRender(label, nil)
+	g.OnRender(panel, nil)
+	g.Refresh(&testEvent{}, panel, other)
+	assert.Equal(t, []string{"other"}, calls)
+	g.OnRender(other, nil)
+
+	// binder refresh runs hooks of changed comps
+	calls = nil
+	tb := g.MakeTextBox("", Options{})
+	g.OnRender(tb, func() { calls = append(calls, "tb") })
+	defer g.OnRender(tb, nil)
+	text := "a"
+	b := g.NewBinder()
+	b.BindTextBox(tb, &text)
+	b.Refresh(&testEvent{})
+	assert.Nil(t, calls)
+	text = "b"
+	b.Refresh(&testEvent{})
+	assert.Equal(t, []string{"tb"}, calls)
+}
+
+func TestGuiBuilder_OnRender_ForgetTree(t *testing.T) {
+	g := &GuiBuilder{}
+	panel := g.MakePanel(Options{})
+	label := g.MakeLabel("", Options{})
+	panel.Add(label)
+
+	calls := 0
+	g.OnRender(label, func() { calls++ })
+
+	// the hooks belong to the builder they were registered with
+	other := &GuiBuilder{}
+	other.Refresh(&testEvent{}, panel)
+	assert.Equal(t, 0, calls)
+	g.Refresh(&testEvent{}, panel)
+	assert.Equal(t, 1, calls)
+
+	// forgetting a tree removes the hooks of its components
+	g.ForgetTree(panel)
+	g.Refresh(&testEvent{}, panel)
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, g.hooks)
+}
@@ -0,0 +1,53 @@
+package wgowut
+
+import (
+	"log"
+	"runtime/debug"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// InternalErrorMessage is the message displayed by the handlers of SafeHandler when their function panics.
+const InternalErrorMessage = "Internal error, please try again."
+
+// SafeHandler returns an event handler calling fn and recovering from its panics, so a failing handler doesn't leave
+// the user staring at a page that stopped responding. Errors returned by fn and recovered panics are logged with the
+// log package, with the stack of panics, and displayed as error notifications by the Notifier of the window of the
+// event source (see NewNotifier); a notifier is added to the window if it has none. Errors are displayed with their
+// message, panics with InternalErrorMessage.
+func (g *GuiBuilder) SafeHandler(fn func(gwu.Event) error) func(gwu.Event) {
+	return func(e gwu.Event) {
+		message := ""
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("wgowut: panic in event handler: %v\n%s", r, debug.Stack())
+					message = InternalErrorMessage
+				}
+			}()
+			if err := fn(e); err != nil {
+				log.Printf("wgowut: event handler error: %v", err)
+				message = err.Error()
+			}
+		}()
+
+		if message != "" {
+			g.notifyError(e, message)
+		}
+	}
+}
+
+// notifyError displays message as an error notification in the window of the source of e.
+func (g *GuiBuilder) notifyError(e gwu.Event, message string) {
+	panel := eventRoot(e)
+	if panel == nil {
+		return
+	}
+
+	n := windowNotifier(panel)
+	if n == nil {
+		n = g.newNotifier(panel)
+		e.MarkDirty(panel)
+	}
+	n.Notify(e, NotifyError, message)
+}
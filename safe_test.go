@@ -0,0 +1,58 @@
+package wgowut
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_SafeHandler(t *testing.T) {
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "main", Options{})
+	btn := g.MakeButton("Save", Options{})
+	win.Add(btn)
+
+	// a notifier is added to the window for the first error
+	e := &testEvent{etype: gwu.ETypeClick, src: btn}
+	g.SafeHandler(func(e gwu.Event) error { return errors.New("disk full") })(e)
+	n := windowNotifier(win)
+	if assert.NotNil(t, n) {
+		assert.Equal(t, 1, n.Count())
+		assert.Equal(t, "disk full", n.panel.CompAt(0).(gwu.Label).Text())
+		if assert.Len(t, e.dirty, 3) {
+			assert.Equal(t, win.ID(), e.dirty[0].ID()) // the panel embedded by the window
+			assert.Equal(t, []gwu.Comp{n.panel, n.timer}, e.dirty[1:])
+		}
+	}
+	assert.Contains(t, logged.String(), "event handler error: disk full")
+
+	logged.Reset()
+	e = &testEvent{etype: gwu.ETypeClick, src: btn}
+	g.SafeHandler(func(e gwu.Event) error { panic("nil map") })(e)
+	assert.Equal(t, n, windowNotifier(win))
+	assert.Equal(t, 2, n.Count())
+	assert.Equal(t, InternalErrorMessage, n.panel.CompAt(0).(gwu.Label).Text())
+	assert.Equal(t, []gwu.Comp{n.panel, n.timer}, e.dirty)
+	assert.Contains(t, logged.String(), "panic in event handler: nil map")
+	assert.Contains(t, logged.String(), "goroutine")
+
+	logged.Reset()
+	called := false
+	g.SafeHandler(func(e gwu.Event) error { called = true; return nil })(&testEvent{etype: gwu.ETypeClick, src: btn})
+	assert.True(t, called)
+	assert.Equal(t, 2, n.Count())
+	assert.Empty(t, logged.String())
+
+	// errors of components outside of windows are only logged
+	g.SafeHandler(func(e gwu.Event) error { return errors.New("lost") })(&testEvent{src: g.MakeLabel("", Options{})})
+	assert.Contains(t, logged.String(), "lost")
+}
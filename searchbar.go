@@ -0,0 +1,169 @@
+package wgowut
+
+import (
+	"strings"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// SearchDelay is the time a SearchBar waits after the last key stroke before calling the search function.
+const SearchDelay = 300 * time.Millisecond
+
+// SearchResult is a single result of a SearchBar search. Text is displayed in the results, Detail is displayed
+// below it in a smaller font if not blank, and Value can hold anything the application needs when the result is picked.
+type SearchResult struct {
+	Text   string
+	Detail string
+	Value  interface{}
+}
+
+// SearchBar is a gwu.Panel created by GuiBuilder.MakeSearchBar holding a text box, a floating results panel and the
+// timer used to debounce searches.
+type SearchBar struct {
+	gwu.Panel
+
+	search      func(q string) []SearchResult
+	onPick      func(SearchResult)
+	tb          gwu.TextBox
+	results     gwu.Panel
+	timer       gwu.Timer
+	items       []SearchResult
+	highlighted int
+}
+
+// MakeSearchBar creates a SearchBar. The search function is called with the trimmed text once the user stops typing
+// for SearchDelay (or presses enter) and the results are shown in a panel floating below the text box. Results can be
+// picked by clicking them, or highlighted with the up and down arrow keys and picked with enter; escape closes the
+// results. When a result is picked the results are closed, the text box is set to its Text and onPick is called.
+// The following options are used for the text box:
+//
+// WhiteSpace, BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background
+func (g *GuiBuilder) MakeSearchBar(search func(q string) []SearchResult, onPick func(SearchResult), options Options) *SearchBar {
+	sb := &SearchBar{
+		Panel:       g.MakePanel(Options{}),
+		search:      search,
+		onPick:      onPick,
+		tb:          g.MakeTextBox("", options),
+		results:     g.MakePanel(Options{BorderWidth: 1, BorderStyle: gwu.BrdStyleSolid, BorderColor: gwu.ClrSilver, Background: gwu.ClrWhite}),
+		timer:       gwu.NewTimer(SearchDelay),
+		highlighted: -1,
+	}
+
+	setAriaLabel(sb.tb, "Search")
+	sb.results.Style().Set("position", "absolute").Set("z-index", "100").SetDisplay("none")
+
+	sb.timer.SetActive(false)
+	sb.timer.AddEHandlerFunc(sb.handleTimer, gwu.ETypeStateChange)
+
+	sb.tb.AddSyncOnETypes(gwu.ETypeKeyUp)
+	sb.tb.AddEHandlerFunc(sb.handleKeyUp, gwu.ETypeKeyUp)
+
+	g.AddCompsToPanel(sb.Panel, sb.tb, sb.results, sb.timer)
+
+	return sb
+}
+
+// TextBox returns the text box of the search bar.
+func (sb *SearchBar) TextBox() gwu.TextBox {
+	return sb.tb
+}
+
+// Results returns the results of the last search.
+func (sb *SearchBar) Results() []SearchResult {
+	return sb.items
+}
+
+func (sb *SearchBar) handleKeyUp(e gwu.Event) {
+	switch e.KeyCode() {
+	case gwu.KeyUp, gwu.KeyDown:
+		if len(sb.items) == 0 {
+			return
+		}
+		if e.KeyCode() == gwu.KeyDown {
+			sb.highlighted = (sb.highlighted + 1) % len(sb.items)
+		} else if sb.highlighted <= 0 {
+			sb.highlighted = len(sb.items) - 1
+		} else {
+			sb.highlighted--
+		}
+		sb.renderResults()
+		e.MarkDirty(sb.results)
+	case gwu.KeyEnter:
+		// the pending search of the typed keys is done now, so the timer doesn't repeat it
+		sb.timer.SetActive(false)
+		e.MarkDirty(sb.timer)
+		if sb.highlighted >= 0 {
+			sb.pick(sb.items[sb.highlighted], e)
+		} else {
+			sb.runSearch(e)
+		}
+	case gwu.KeyEscape:
+		sb.close(e)
+	default:
+		sb.timer.SetActive(true)
+		sb.timer.Reset()
+		e.MarkDirty(sb.timer)
+	}
+}
+
+func (sb *SearchBar) handleTimer(e gwu.Event) {
+	sb.timer.SetActive(false)
+	sb.runSearch(e)
+}
+
+func (sb *SearchBar) runSearch(e gwu.Event) {
+	sb.items = nil
+	if q := strings.TrimSpace(sb.tb.Text()); q != "" {
+		sb.items = sb.search(q)
+	}
+	sb.highlighted = -1
+	sb.renderResults()
+	e.MarkDirty(sb.results)
+}
+
+func (sb *SearchBar) renderResults() {
+	sb.results.Clear()
+	if len(sb.items) == 0 {
+		sb.results.Style().SetDisplay("none")
+		return
+	}
+	sb.results.Style().SetDisplay("")
+
+	for i, item := range sb.items {
+		item := item
+		row := gwu.NewPanel()
+		row.Style().SetFullWidth().SetCursor(gwu.CursorPointer).SetPadding("2px")
+		if i == sb.highlighted {
+			row.Style().SetBackground(gwu.ClrSilver)
+		}
+
+		row.Add(gwu.NewLabel(item.Text))
+		if item.Detail != "" {
+			detail := gwu.NewLabel(item.Detail)
+			detail.Style().SetColor(gwu.ClrGray).SetFontSize("smaller")
+			row.Add(detail)
+		}
+
+		row.AddEHandlerFunc(func(e gwu.Event) {
+			sb.pick(item, e)
+		}, gwu.ETypeClick)
+
+		sb.results.Add(row)
+	}
+}
+
+func (sb *SearchBar) pick(item SearchResult, e gwu.Event) {
+	sb.tb.SetText(item.Text)
+	sb.close(e)
+	if sb.onPick != nil {
+		sb.onPick(item)
+	}
+}
+
+func (sb *SearchBar) close(e gwu.Event) {
+	sb.items = nil
+	sb.highlighted = -1
+	sb.renderResults()
+	e.MarkDirty(sb)
+}
@@ -0,0 +1,106 @@
+package wgowut
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSearch(q string) []SearchResult {
+	var results []SearchResult
+	for _, host := range []string{"web-1", "web-2", "db-1"} {
+		if strings.HasPrefix(host, q) {
+			results = append(results, SearchResult{Text: host, Detail: "host", Value: len(results)})
+		}
+	}
+	return results
+}
+
+func TestGuiBuilder_MakeSearchBar(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		options Options
+	}{
+		{"set all options", Options{
+			WhiteSpace:  gwu.WhiteSpacePreWrap,
+			BorderWidth: 2,
+			BorderStyle: gwu.BrdStyleDotted,
+			BorderColor: gwu.ClrFuchsia,
+			Width:       "1",
+			Height:      "1",
+			FontSize:    "1",
+			Color:       gwu.ClrMaroon,
+			Background:  gwu.ClrAqua,
+		}},
+		{"set no options", Options{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			got := g.MakeSearchBar(testSearch, nil, tt.options)
+
+			checkStyle(t, got.TextBox().Style(), tt.options)
+			assert.Equal(t, 3, got.CompsCount())
+			assert.Equal(t, false, got.timer.Active())
+			assert.Equal(t, SearchDelay, got.timer.Timeout())
+			assert.Equal(t, "none", got.results.Style().Display())
+		})
+	}
+}
+
+func TestSearchBar_events(t *testing.T) {
+	g := &GuiBuilder{}
+	var picked []SearchResult
+	sb := g.MakeSearchBar(testSearch, func(r SearchResult) { picked = append(picked, r) }, Options{})
+
+	// typing activates the debounce timer
+	sb.tb.SetText("web")
+	e := &testEvent{etype: gwu.ETypeKeyUp, key: gwu.Key('b')}
+	sb.handleKeyUp(e)
+	assert.Equal(t, true, sb.timer.Active())
+	assert.Equal(t, []gwu.Comp{sb.timer}, e.dirty)
+
+	// the timer runs the search
+	e = &testEvent{etype: gwu.ETypeStateChange}
+	sb.handleTimer(e)
+	assert.Equal(t, false, sb.timer.Active())
+	assert.Equal(t, 2, len(sb.Results()))
+	assert.Equal(t, 2, sb.results.CompsCount())
+	assert.Equal(t, "", sb.results.Style().Display())
+	assert.Equal(t, []gwu.Comp{sb.results}, e.dirty)
+
+	// arrow keys wrap around the results
+	sb.handleKeyUp(&testEvent{key: gwu.KeyUp})
+	assert.Equal(t, 1, sb.highlighted)
+	sb.handleKeyUp(&testEvent{key: gwu.KeyDown})
+	assert.Equal(t, 0, sb.highlighted)
+	assert.Equal(t, gwu.ClrSilver, sb.results.CompAt(0).Style().Background())
+
+	// enter picks the highlighted result
+	sb.handleKeyUp(&testEvent{key: gwu.KeyEnter})
+	assert.Equal(t, []SearchResult{{Text: "web-1", Detail: "host", Value: 0}}, picked)
+	assert.Equal(t, "web-1", sb.tb.Text())
+	assert.Equal(t, "none", sb.results.Style().Display())
+
+	// enter without a highlighted result searches immediately, escape closes
+	sb.tb.SetText("db")
+	sb.handleKeyUp(&testEvent{key: gwu.KeyA + 1})
+	assert.True(t, sb.timer.Active())
+	e = &testEvent{key: gwu.KeyEnter}
+	sb.handleKeyUp(e)
+	assert.Equal(t, 1, len(sb.Results()))
+	assert.False(t, sb.timer.Active())
+	assert.Equal(t, []gwu.Comp{sb.timer, sb.results}, e.dirty)
+	sb.handleKeyUp(&testEvent{key: gwu.KeyEscape})
+	assert.Equal(t, 0, len(sb.Results()))
+	assert.Equal(t, 1, len(picked))
+
+	// arrow keys without results do nothing
+	e = &testEvent{key: gwu.KeyDown}
+	sb.handleKeyUp(e)
+	assert.Equal(t, -1, sb.highlighted)
+	assert.Nil(t, e.dirty)
+}
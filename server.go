@@ -0,0 +1,313 @@
+package wgowut
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+const (
+	// DefaultServerAddr is the address of the server if ServerConfig.Addr is empty, the default of gwu.
+	DefaultServerAddr = "localhost:3434"
+	// DefaultShutdownTimeout is how long StartServer waits for in-flight events on SIGINT or SIGTERM if
+	// ServerConfig.ShutdownTimeout is 0.
+	DefaultShutdownTimeout = 10 * time.Second
+
+	// defaultSessIDCookieName is the name of the session id cookie of gwu servers, unless changed.
+	defaultSessIDCookieName = "gwu-sessid"
+	// gwuStaticPath is the path, relative to the app path, under which gwu serves its static contents.
+	gwuStaticPath = "_gwu_static/"
+)
+
+// ServerConfig is the configuration of the GUI server made by NewServer and StartServer.
+type ServerConfig struct {
+	AppName         string        // AppName is the first part of the app path; the app is served at the root if empty.
+	Addr            string        // Addr is the server address, DefaultServerAddr if empty; port 0 picks a free port.
+	Text            string        // Text is the title of the window list of the server, AppName if empty.
+	Windows         []gwu.Window  // Windows are the public windows of the app.
+	Logger          *log.Logger   // Logger is the logger of the server, nothing is logged if nil.
+	ShutdownTimeout time.Duration // ShutdownTimeout is how long StartServer waits for in-flight events on shutdown.
+
+	// SessionWindows maps the names of the private windows made by the OnSessionCreated functions to their text in the
+	// window list. Opening one of them creates a session (see gwu.Server.AddSessCreatorName); the text may be empty to
+	// leave the window out of the list.
+	SessionWindows map[string]string
+
+	// Middleware wraps the handler of gwu, for example for request logging, authentication or metrics. The first
+	// middleware is the outermost, seeing the requests first. It applies to the Handler of the server too.
+	Middleware []func(http.Handler) http.Handler
+}
+
+// Server wraps a gwu server, serving it with its own http.Server that can be shut down gracefully, or with the
+// http.Handler returned by Handler.
+type Server struct {
+	gwu.Server
+	handler http.Handler
+	http    *http.Server
+
+	mu   sync.Mutex
+	addr string // addr is the address of the app URL, with the port listened on once started
+}
+
+// NewServer creates a gwu server from cfg and adds the windows to it. The returned server is started with Start.
+//
+// gwu can only serve with http.ListenAndServe, registering its handlers with http.DefaultServeMux first. To be able to
+// shut the server down, NewServer registers the handlers without serving them (see register), and Start serves them on
+// a listener of its own. As with gwu, each server needs a distinct AppName.
+func (g *GuiBuilder) NewServer(cfg ServerConfig) (*Server, error) {
+	if cfg.Addr == "" {
+		cfg.Addr = DefaultServerAddr
+	}
+	if _, _, err := net.SplitHostPort(cfg.Addr); err != nil {
+		return nil, fmt.Errorf("wgowut: invalid address: %v", err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("wgowut: could not register the handlers of gwu: %v", err)
+	}
+	defer l.Close()
+
+	gwuCfg := cfg
+	gwuCfg.Addr = l.Addr().String()
+	server, err := g.newGwuServer(gwuCfg)
+	if err != nil {
+		return nil, err
+	}
+	register(server)
+	if cfg.Logger != nil {
+		server.SetLogger(cfg.Logger)
+	}
+
+	var handler http.Handler = appHandler{patterns: map[string]bool{
+		server.AppPath():                   true,
+		server.AppPath() + gwuStaticPath:   true,
+		endpointPath(server.AppPath(), ""): true,
+	}}
+	for i := len(cfg.Middleware) - 1; i >= 0; i-- {
+		handler = cfg.Middleware[i](handler)
+	}
+	return &Server{Server: server, handler: handler, http: &http.Server{Handler: handler}, addr: cfg.Addr}, nil
+}
+
+// registerMu serializes register, which replaces the output of the standard logger.
+var registerMu sync.Mutex
+
+// register registers the handlers of server with http.DefaultServeMux. gwu only registers them in Start, right
+// before listening on the address of the server, so server has to be made with the address of a listener held by the
+// caller: listening fails and Start returns, whatever the error. The line gwu logs on start with the standard logger,
+// which would show the address of that listener, is dropped.
+func register(server gwu.Server) {
+	registerMu.Lock()
+	defer registerMu.Unlock()
+
+	out := log.Writer()
+	log.SetOutput(dropWriter{w: out, drop: []byte("Starting GUI server on: " + server.AppURL() + "\n")})
+	defer log.SetOutput(out)
+
+	server.Start()
+}
+
+// dropWriter writes to w, dropping the writes ending with drop.
+type dropWriter struct {
+	w    io.Writer
+	drop []byte
+}
+
+func (d dropWriter) Write(p []byte) (int, error) {
+	if bytes.HasSuffix(p, d.drop) {
+		return len(p), nil
+	}
+	return d.w.Write(p)
+}
+
+// appHandler serves the requests of an app with http.DefaultServeMux, where gwu and the wgowut endpoints register
+// their handlers. Only the requests matching the patterns of the app are served, so other handlers registered with
+// the default mux, like those of net/http/pprof, aren't exposed.
+type appHandler struct {
+	patterns map[string]bool
+}
+
+func (h appHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	handler, pattern := http.DefaultServeMux.Handler(r)
+	if !h.patterns[pattern] {
+		http.NotFound(w, r)
+		return
+	}
+	handler.ServeHTTP(w, r)
+}
+
+// newGwuServer creates the gwu server of cfg, without starting it or setting its logger.
+func (g *GuiBuilder) newGwuServer(cfg ServerConfig) (gwu.Server, error) {
+	server := gwu.NewServer(cfg.AppName, cfg.Addr)
+
+	text := cfg.Text
+	if text == "" {
+		text = cfg.AppName
+	}
+	server.SetText(text)
+
+	for name, text := range cfg.SessionWindows {
+		server.AddSessCreatorName(name, text)
+	}
+	server.AddSHandler(sessionHandler{g: g, server: server})
+
+	for i, win := range cfg.Windows {
+		if win == nil {
+			return nil, fmt.Errorf("wgowut: window %d is nil", i)
+		}
+		if err := server.AddWin(win); err != nil {
+			return nil, fmt.Errorf("wgowut: %v", err)
+		}
+	}
+
+	g.mu.Lock()
+	g.server = server
+	g.mu.Unlock()
+
+	return server, nil
+}
+
+// Handler returns the handler of the app, to serve it from another server, like one serving REST endpoints too. It
+// only serves the app, its static contents and the wgowut endpoints, like those of uploads. The handler has to be
+// mounted at the app path (see gwu.Server.AppPath), since gwu renders absolute URLs under it; to
+// serve it at a different path, change the AppName. Addr of the config should be the address of the other server, it
+// is used in the app URL.
+func (s *Server) Handler() http.Handler {
+	return s.handler
+}
+
+// AttachTo mounts the app on mux at the app path, the path made from the AppName of the config. Start should not be
+// called on a server attached to a mux.
+func (s *Server) AttachTo(mux *http.ServeMux) {
+	mux.Handle(s.AppPath(), s.handler)
+}
+
+// AppURL returns the URL of the app, made of the address of the config, with the port listened on once started if it
+// was 0.
+func (s *Server) AppURL() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return "http://" + s.addr + s.AppPath()
+}
+
+// Start starts serving the app. It blocks while the server runs, and returns nil after Shutdown, or the error of
+// listening or serving.
+func (s *Server) Start() error {
+	l, err := s.listen()
+	if err != nil {
+		return err
+	}
+	return s.serve(l)
+}
+
+// listen listens on the address of the server, setting the port of the app URL if it was 0.
+func (s *Server) listen() (net.Listener, error) {
+	s.mu.Lock()
+	addr := s.addr
+	s.mu.Unlock()
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	host, _, _ := net.SplitHostPort(addr) // checked by NewServer
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+	s.mu.Lock()
+	s.addr = net.JoinHostPort(host, port)
+	s.mu.Unlock()
+	return l, nil
+}
+
+// serve serves the app on l, closing it when done.
+func (s *Server) serve(l net.Listener) error {
+	defer l.Close()
+
+	if logger := s.Logger(); logger != nil {
+		logger.Println("Starting GUI server on:", s.AppURL())
+	}
+	if err := s.http.Serve(l); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// Shutdown stops the server gracefully: it closes the listener, so no more sessions are created, and waits for the
+// in-flight requests, like events being handled, to complete. It returns the error of ctx if it is done first.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.http.Shutdown(ctx)
+}
+
+// ShutdownOnSignal shuts the server down when the process receives one of the signals, waiting at most timeout for
+// the in-flight requests. SIGINT and SIGTERM are used if no signals are given. The returned function stops relaying
+// the signals, it should be called once the server is done.
+func (s *Server) ShutdownOnSignal(timeout time.Duration, signals ...os.Signal) (stop func()) {
+	if len(signals) == 0 {
+		signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	}
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, signals...)
+	done := make(chan struct{})
+	var once sync.Once
+
+	go func() {
+		var sig os.Signal
+		select {
+		case sig = <-c:
+			signal.Stop(c)
+		case <-done:
+			return
+		}
+		if logger := s.Logger(); logger != nil {
+			logger.Println("Shutting down GUI server on signal:", sig)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := s.Shutdown(ctx); err != nil {
+			log.Printf("wgowut: shutdown: %v", err)
+		}
+	}()
+
+	return func() {
+		once.Do(func() {
+			signal.Stop(c)
+			close(done)
+		})
+	}
+}
+
+// StartServer creates a server from cfg with NewServer and starts it, shutting it down gracefully on SIGINT or
+// SIGTERM once it listens. It blocks while the server runs, and returns the error of creating, listening or serving.
+func (g *GuiBuilder) StartServer(cfg ServerConfig) error {
+	server, err := g.NewServer(cfg)
+	if err != nil {
+		return err
+	}
+
+	timeout := cfg.ShutdownTimeout
+	if timeout == 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	l, err := server.listen()
+	if err != nil {
+		return err
+	}
+	stop := server.ShutdownOnSignal(timeout)
+	defer stop()
+
+	return server.serve(l)
+}
@@ -0,0 +1,337 @@
+package wgowut
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_newGwuServer(t *testing.T) {
+	g := &GuiBuilder{}
+	main := g.MakeWindow("main", "Main", Options{})
+	server, err := g.newGwuServer(ServerConfig{AppName: "app", Windows: []gwu.Window{main}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "http://localhost:3434/app/", server.AppURL())
+		assert.Equal(t, "app", server.Text())
+		assert.Nil(t, server.Logger())
+		assert.Equal(t, main, server.WinByName("main"))
+	}
+
+	server, err = g.newGwuServer(ServerConfig{Addr: "127.0.0.1:8080", Text: "Monitor"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "http://127.0.0.1:8080/", server.AppURL())
+		assert.Equal(t, "Monitor", server.Text())
+	}
+}
+
+func TestGuiBuilder_StartServer(t *testing.T) {
+	g := &GuiBuilder{}
+	main := g.MakeWindow("main", "Main", Options{})
+
+	err := g.StartServer(ServerConfig{Windows: []gwu.Window{main, g.MakeWindow("main", "Other", Options{})}})
+	assert.EqualError(t, err, "wgowut: A window with the same name has already been added: main")
+
+	err = g.StartServer(ServerConfig{Windows: []gwu.Window{main, nil}})
+	assert.EqualError(t, err, "wgowut: window 1 is nil")
+
+	// the signals aren't relayed if listening fails
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer l.Close()
+	err = g.StartServer(ServerConfig{AppName: appName(), Addr: l.Addr().String()})
+	assert.Error(t, err)
+}
+
+// appNames counts the app names returned by appName: gwu panics if a server with the same name is started twice.
+var appNames int32
+
+// appName returns a unique app name.
+func appName() string {
+	return "app" + strconv.Itoa(int(atomic.AddInt32(&appNames, 1)))
+}
+
+// get returns the status and body of the response to a GET request.
+func get(url string) (int, string, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	return resp.StatusCode, string(body), err
+}
+
+// startServer starts the server, returning when it accepts connections, and the channel of the error of Start.
+func startServer(t *testing.T, server *Server) <-chan error {
+	started := make(chan error, 1)
+	go func() {
+		started <- server.Start()
+	}()
+	for i := 0; i < 500; i++ {
+		u, _ := url.Parse(server.AppURL())
+		if u.Port() != "0" {
+			if conn, err := net.Dial("tcp", u.Host); err == nil {
+				conn.Close()
+				return started
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("server not started")
+	return nil
+}
+
+func TestGuiBuilder_NewServer(t *testing.T) {
+	g := &GuiBuilder{}
+	_, err := g.NewServer(ServerConfig{Addr: "localhost"})
+	assert.Error(t, err)
+
+	// gwu doesn't log the address its handlers are registered with
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	_, err = g.NewServer(ServerConfig{AppName: appName()})
+	log.SetOutput(os.Stderr)
+	assert.NoError(t, err)
+	assert.Empty(t, buf.String())
+
+	// the logger is set after the handlers are registered
+	logger := log.New(os.Stderr, "", 0)
+	server, err := g.NewServer(ServerConfig{AppName: appName(), Logger: logger})
+	if assert.NoError(t, err) {
+		assert.Equal(t, logger, server.Logger())
+		assert.Equal(t, "http://localhost:3434"+server.AppPath(), server.AppURL())
+	}
+
+	main := g.MakeWindow("main", "Main", Options{})
+	release, handling := make(chan bool), make(chan bool)
+	handled := false
+	btn := g.MakeButton("Save", Options{OnClick: func(e gwu.Event) {
+		handling <- true
+		<-release
+		handled = true
+	}})
+	main.Add(btn)
+
+	server, err = g.NewServer(ServerConfig{AppName: appName(), Addr: "127.0.0.1:0", Windows: []gwu.Window{main}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "http://127.0.0.1:0"+server.AppPath(), server.AppURL())
+	started := startServer(t, server)
+	assert.NotContains(t, server.AppURL(), ":0/")
+
+	status, body, err := get(server.AppURL() + "main")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, "Save")
+
+	// an in-flight event completes on shutdown
+	event := make(chan int)
+	go func() {
+		status, _, _ := get(server.AppURL() + "main/e?et=" + strconv.Itoa(int(gwu.ETypeClick)) + "&cid=" + btn.ID().String())
+		event <- status
+	}()
+	<-handling
+	shutdown := make(chan error)
+	go func() {
+		shutdown <- server.Shutdown(context.Background())
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	assert.Equal(t, http.StatusOK, <-event)
+	assert.True(t, handled)
+	assert.NoError(t, <-shutdown)
+	assert.NoError(t, <-started)
+	_, _, err = get(server.AppURL() + "main")
+	assert.Error(t, err)
+}
+
+func TestServer_ShutdownOnSignal(t *testing.T) {
+	g := &GuiBuilder{}
+	server, err := g.NewServer(ServerConfig{AppName: appName(), Addr: "127.0.0.1:0"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	started := startServer(t, server)
+
+	stop := server.ShutdownOnSignal(time.Second)
+	defer stop()
+	process, _ := os.FindProcess(os.Getpid()) // never fails on Unix
+	assert.NoError(t, process.Signal(os.Interrupt))
+	select {
+	case err := <-started:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Error("server not shut down")
+	}
+}
+
+func TestServer_AttachTo(t *testing.T) {
+	g := &GuiBuilder{}
+	main := g.MakeWindow("main", "Main", Options{})
+	main.Add(g.MakeLabel("Dashboard", Options{}))
+
+	server, err := g.NewServer(ServerConfig{AppName: appName(), Addr: "127.0.0.1:0", Windows: []gwu.Window{main}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	upload := g.MakeFileUpload(Options{}, nil)
+	defer upload.Close()
+	assert.True(t, strings.HasPrefix(upload.URL(), server.AppPath()+"_wgowut/upload/"))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("healthy"))
+	})
+	server.AttachTo(mux)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	status, body, err := get(ts.URL + "/api/health")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "healthy", body)
+
+	status, body, err = get(ts.URL + server.AppPath() + "main")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, "Dashboard")
+
+	status, _, err = get(ts.URL + "/other")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	resp, err := http.Post(ts.URL+upload.URL()+"?name=a.txt", "text/plain", strings.NewReader("a"))
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestServer_Handler(t *testing.T) {
+	g := &GuiBuilder{}
+	main := g.MakeWindow("main", "Main", Options{})
+	server, err := g.NewServer(ServerConfig{AppName: appName(), Windows: []gwu.Window{main}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	ts := httptest.NewServer(server.Handler())
+	defer ts.Close()
+
+	// handlers registered with the default mux by others aren't exposed, even under the app path
+	for _, path := range []string{"/debug/" + server.AppPath()[1:], server.AppPath() + "secret/"} {
+		http.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("secret"))
+		})
+		status, body, err := get(ts.URL + path)
+		assert.NoError(t, err)
+		assert.NotEqual(t, "secret", body)
+		assert.Equal(t, http.StatusNotFound, status)
+	}
+
+	status, _, err := get(ts.URL + server.AppPath() + "main")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestServerConfig_Middleware(t *testing.T) {
+	g := &GuiBuilder{}
+	main := g.MakeWindow("main", "Main", Options{})
+
+	var calls []string
+	logging := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, "log "+r.URL.Path)
+			next.ServeHTTP(w, r)
+		})
+	}
+	auth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, "auth")
+			if r.Header.Get("Authorization") == "" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	server, err := g.NewServer(ServerConfig{AppName: appName(), Addr: "127.0.0.1:0", Windows: []gwu.Window{main},
+		Middleware: []func(http.Handler) http.Handler{logging, auth}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	mux := http.NewServeMux()
+	server.AttachTo(mux)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	status, _, err := get(ts.URL + server.AppPath() + "main")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Equal(t, []string{"log " + server.AppPath() + "main", "auth"}, calls)
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+server.AppPath()+"main", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := http.DefaultClient.Do(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestServerConfig_SessionWindows(t *testing.T) {
+	g := &GuiBuilder{}
+	g.OnSessionCreated(func(sess gwu.Session, b *GuiBuilder) {
+		win := b.MakeWindow("private", "Private", Options{})
+		win.Add(b.MakeLabel("session "+sess.ID(), Options{}))
+		sess.AddWin(win)
+	})
+
+	server, err := g.NewServer(ServerConfig{AppName: appName(), Addr: "127.0.0.1:0",
+		SessionWindows: map[string]string{"private": "Private"}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	mux := http.NewServeMux()
+	server.AttachTo(mux)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	_, list, err := get(ts.URL + server.AppPath())
+	assert.NoError(t, err)
+	assert.Contains(t, list, "Private")
+
+	var bodies []string
+	for i := 0; i < 2; i++ {
+		jar, _ := cookiejar.New(nil) // never fails without options
+		resp, err := (&http.Client{Jar: jar}).Get(ts.URL + server.AppPath() + "private")
+		if !assert.NoError(t, err) {
+			return
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, string(body), "session ")
+		bodies = append(bodies, string(body))
+	}
+	assert.NotEqual(t, bodies[0], bodies[1])
+}
@@ -0,0 +1,84 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// sessionBuilderAttr is the session attribute holding the GuiBuilder of the session.
+const sessionBuilderAttr = "wgowut.GuiBuilder"
+
+// NewSessionBuilder returns the GuiBuilder of the session, creating and storing it in a session attribute on the first
+// call. The builder carries the session, so helpers that need it don't require it to be passed to every call, and has
+// its own registries (see CloneTree), which are discarded with the session. Call it from a gwu.SessionHandler or with
+// e.Session() from an event handler.
+func NewSessionBuilder(sess gwu.Session) *GuiBuilder {
+	if g, ok := sess.Attr(sessionBuilderAttr).(*GuiBuilder); ok {
+		return g
+	}
+	g := &GuiBuilder{session: sess}
+	sess.SetAttr(sessionBuilderAttr, g)
+	return g
+}
+
+// Session returns the session of a builder created with NewSessionBuilder, or nil.
+func (g *GuiBuilder) Session() gwu.Session {
+	return g.session
+}
+
+// appPath returns the app path of the server of the builder, or the root if it has none.
+func (g *GuiBuilder) appPath() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.server == nil {
+		return "/"
+	}
+	return g.server.AppPath()
+}
+
+// sessIDCookieName returns the name of the session id cookie of the server of the builder, or the default of gwu if
+// it has none.
+func (g *GuiBuilder) sessIDCookieName() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.server == nil {
+		return defaultSessIDCookieName
+	}
+	return g.server.SessIDCookieName()
+}
+
+// OnSessionCreated registers fn to be called when a session is created by a server made with NewServer or
+// StartServer, with the builder of the session (see NewSessionBuilder) to make its private windows, which fn adds to
+// the session. Sessions are created when a window of ServerConfig.SessionWindows is opened, or by an event handler
+// calling gwu.Event.NewSession. The functions are called in the order they were registered.
+func (g *GuiBuilder) OnSessionCreated(fn func(sess gwu.Session, b *GuiBuilder)) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.created = append(g.created, fn)
+}
+
+// sessionHandler is the gwu.SessionHandler calling the OnSessionCreated functions of a builder.
+type sessionHandler struct {
+	g      *GuiBuilder
+	server gwu.Server
+}
+
+// Created calls the OnSessionCreated functions with the builder of sess.
+func (h sessionHandler) Created(sess gwu.Session) {
+	h.g.mu.Lock()
+	fns := h.g.created
+	h.g.mu.Unlock()
+
+	b := NewSessionBuilder(sess)
+	b.mu.Lock()
+	b.server = h.server
+	b.mu.Unlock()
+	for _, fn := range fns {
+		fn(sess, b)
+	}
+}
+
+// Removed does nothing, the builder of the session is discarded with it.
+func (h sessionHandler) Removed(sess gwu.Session) {}
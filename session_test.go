@@ -0,0 +1,75 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSession is a gwu.Session fake storing attributes. Methods not overridden panic if called.
+type testSession struct {
+	gwu.Session
+	id    string
+	attrs map[string]interface{}
+}
+
+func newTestSession(id string) *testSession {
+	return &testSession{id: id, attrs: make(map[string]interface{})}
+}
+
+func (s *testSession) ID() string {
+	return s.id
+}
+
+func (s *testSession) Attr(name string) interface{} {
+	return s.attrs[name]
+}
+
+func (s *testSession) SetAttr(name string, value interface{}) {
+	if value == nil {
+		delete(s.attrs, name)
+		return
+	}
+	s.attrs[name] = value
+}
+
+func TestNewSessionBuilder(t *testing.T) {
+	sess, other := newTestSession("a"), newTestSession("b")
+
+	g := NewSessionBuilder(sess)
+	assert.Equal(t, gwu.Session(sess), g.Session())
+	assert.Same(t, g, NewSessionBuilder(sess))
+
+	otherG := NewSessionBuilder(other)
+	assert.NotSame(t, g, otherG)
+	assert.Equal(t, gwu.Session(other), otherG.Session())
+
+	// registries are per session
+	g.EnableCloning()
+	otherG.EnableCloning()
+	label := g.MakeLabel("template", Options{})
+	assert.NotNil(t, g.CloneTree(label))
+	assert.Nil(t, otherG.CloneTree(label))
+
+	assert.Nil(t, NewGuiBuilder().Session())
+}
+
+func TestGuiBuilder_OnSessionCreated(t *testing.T) {
+	g := NewGuiBuilder()
+	server := gwu.NewServer("sessiontest", "")
+	var calls []string
+	g.OnSessionCreated(func(sess gwu.Session, b *GuiBuilder) {
+		assert.Same(t, NewSessionBuilder(sess), b)
+		assert.Equal(t, server, b.server)
+		calls = append(calls, "first "+sess.ID())
+	})
+	g.OnSessionCreated(func(sess gwu.Session, b *GuiBuilder) {
+		calls = append(calls, "second "+sess.ID())
+	})
+
+	h := sessionHandler{g: g, server: server}
+	h.Created(newTestSession("a"))
+	h.Removed(newTestSession("a"))
+	assert.Equal(t, []string{"first a", "second a"}, calls)
+}
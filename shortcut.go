@@ -0,0 +1,150 @@
+package wgowut
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// keyQuestionMark is the key code the shortcut listener reports for "?", which has no key code of its own.
+const keyQuestionMark gwu.Key = 63
+
+// Shortcut is a keyboard shortcut of a window bound with BindShortcut.
+type Shortcut struct {
+	Key         gwu.Key
+	Description string
+}
+
+// shortcutListener is the hidden component of a window receiving the keys pressed outside of inputs, holding the
+// shortcuts of the window and its help overlay.
+type shortcutListener struct {
+	gwu.HTML
+	g         *GuiBuilder
+	shortcuts []Shortcut
+	handlers  map[gwu.Key]func(gwu.Event)
+	help      gwu.Panel
+}
+
+// windowShortcuts returns the shortcut listener of win, adding it on the first call.
+func windowShortcuts(win gwu.Window) *shortcutListener {
+	for i := 0; i < win.CompsCount(); i++ {
+		if sl, ok := win.CompAt(i).(*shortcutListener); ok {
+			return sl
+		}
+	}
+
+	sl := &shortcutListener{HTML: gwu.NewHTML(""), handlers: make(map[gwu.Key]func(gwu.Event))}
+	sl.AddEHandlerFunc(sl.handleKey, gwu.ETypeKeyUp)
+	win.Add(sl)
+	return sl
+}
+
+// Render renders the script sending the keys released outside of inputs (except the modifier keys) to the listener.
+// The script runs when the window is loaded; re-rendering the listener doesn't register it again.
+func (sl *shortcutListener) Render(w gwu.Writer) {
+	w.Writes(fmt.Sprintf(`<script>document.addEventListener('keyup',function(e){var t=e.target.tagName;`+
+		`if(t=='INPUT'||t=='TEXTAREA'||t=='SELECT'||(e.keyCode>=16&&e.keyCode<=18))return;`+
+		`se({which:e.key=='?'?%d:e.keyCode},%d,%d);});</script>`, int(keyQuestionMark), int(gwu.ETypeKeyUp), int(sl.ID())))
+}
+
+// BindShortcut calls fn when key is pressed in win while no input has the focus. The description lists the shortcut
+// in the help overlay (see RegisterShortcutHelp). Binding a key again replaces its shortcut.
+func (g *GuiBuilder) BindShortcut(win gwu.Window, key gwu.Key, description string, fn func(gwu.Event)) {
+	sl := windowShortcuts(win)
+	if _, bound := sl.handlers[key]; !bound {
+		sl.shortcuts = append(sl.shortcuts, Shortcut{key, description})
+	} else {
+		for i := range sl.shortcuts {
+			if sl.shortcuts[i].Key == key {
+				sl.shortcuts[i].Description = description
+			}
+		}
+	}
+	sl.handlers[key] = fn
+}
+
+// Shortcuts returns the shortcuts bound to win with BindShortcut, in the order they were bound.
+func (g *GuiBuilder) Shortcuts(win gwu.Window) []Shortcut {
+	return append([]Shortcut(nil), windowShortcuts(win).shortcuts...)
+}
+
+// RegisterShortcutHelp adds an overlay to win listing its shortcuts bound with BindShortcut, so they are
+// discoverable. The overlay is shown and hidden by pressing "?" and hidden by escape.
+func (g *GuiBuilder) RegisterShortcutHelp(win gwu.Window) {
+	sl := windowShortcuts(win)
+	if sl.help != nil {
+		return
+	}
+	sl.g = g
+
+	sl.help = g.MakePanel(Options{CellPadding: 10, BorderWidth: 1, BorderStyle: gwu.BrdStyleSolid, BorderColor: gwu.ClrGray, Background: gwu.ClrWhite})
+	sl.help.Style().Set("position", "fixed").Set("top", "20%").Set("left", "50%").Set("transform", "translateX(-50%)").
+		Set("z-index", "1000").Set("box-shadow", "0 4px 16px rgba(0,0,0,0.3)").SetDisplay(gwu.DisplayNone)
+	win.Add(sl.help)
+}
+
+func (sl *shortcutListener) handleKey(e gwu.Event) {
+	key := e.KeyCode()
+	if sl.help != nil {
+		switch {
+		case key == keyQuestionMark:
+			sl.showHelp(sl.help.Style().Display() == gwu.DisplayNone)
+			e.MarkDirty(sl.help)
+			return
+		case key == gwu.KeyEscape && sl.help.Style().Display() != gwu.DisplayNone:
+			sl.showHelp(false)
+			e.MarkDirty(sl.help)
+			return
+		}
+	}
+
+	if fn := sl.handlers[key]; fn != nil {
+		fn(e)
+	}
+}
+
+// showHelp shows the help overlay listing the current shortcuts, or hides it.
+func (sl *shortcutListener) showHelp(show bool) {
+	if !show {
+		sl.help.Style().SetDisplay(gwu.DisplayNone)
+		return
+	}
+
+	pairs := make([]KV, 0, len(sl.shortcuts)+1)
+	for _, sc := range sl.shortcuts {
+		pairs = append(pairs, KV{keyName(sc.Key), sc.Description})
+	}
+	pairs = append(pairs, KV{keyName(keyQuestionMark), "Show or hide this help"})
+
+	for _, child := range childComps(sl.help) {
+		sl.g.ForgetTree(child)
+	}
+	sl.help.Clear()
+	sl.help.Add(sl.g.MakeLabel("Keyboard shortcuts", Options{FontSize: "120%"}))
+	sl.help.Add(sl.g.MakeKeyValueTable(pairs, Options{CellPadding: 3, HAlign: gwu.HARight, Color: gwu.ClrNavy}, Options{CellPadding: 3}))
+	sl.help.Add(sl.g.MakeLabel("Press ? or Esc to close", Options{Color: gwu.ClrGray, FontSize: "smaller"}))
+	sl.help.Style().SetDisplay("")
+}
+
+// keyNames holds the names of the keys displayed by the shortcut help that are not letters, digits or function keys.
+var keyNames = map[gwu.Key]string{
+	gwu.KeyBackspace: "Backspace", gwu.KeyEnter: "Enter", gwu.KeyEscape: "Esc", gwu.KeySpace: "Space",
+	gwu.KeyPgUp: "Page Up", gwu.KeyPgDown: "Page Down", gwu.KeyEnd: "End", gwu.KeyHome: "Home",
+	gwu.KeyLeft: "Left", gwu.KeyUp: "Up", gwu.KeyRight: "Right", gwu.KeyDown: "Down",
+	gwu.KeyInsert: "Insert", gwu.KeyDel: "Delete", keyQuestionMark: "?",
+}
+
+// keyName returns the name of key displayed by the shortcut help.
+func keyName(key gwu.Key) string {
+	switch {
+	case key >= gwu.KeyA && key <= gwu.KeyZ, key >= gwu.Key0 && key <= gwu.Key9:
+		return string(rune(key))
+	case key >= gwu.KeyF1 && key <= gwu.KeyF12:
+		return "F" + strconv.Itoa(int(key-gwu.KeyF1)+1)
+	}
+	if name, ok := keyNames[key]; ok {
+		return name
+	}
+	return "Key " + strconv.Itoa(int(key))
+}
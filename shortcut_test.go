@@ -0,0 +1,90 @@
+package wgowut
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_BindShortcut(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "Main", Options{})
+	var pressed []string
+	g.BindShortcut(win, gwu.Key('S'), "Save", func(e gwu.Event) { pressed = append(pressed, "save") })
+	g.BindShortcut(win, gwu.KeyF5, "Refresh", func(e gwu.Event) { pressed = append(pressed, "refresh") })
+	g.BindShortcut(win, gwu.Key('S'), "Save all", func(e gwu.Event) { pressed = append(pressed, "save all") })
+
+	assert.Equal(t, []Shortcut{{gwu.Key('S'), "Save all"}, {gwu.KeyF5, "Refresh"}}, g.Shortcuts(win))
+	assert.Equal(t, 1, win.CompsCount())
+	sl := win.CompAt(0).(*shortcutListener)
+
+	var buf bytes.Buffer
+	sl.Render(gwu.NewWriter(&buf))
+	assert.Contains(t, buf.String(), fmt.Sprintf("},%d,%d);", gwu.ETypeKeyUp, sl.ID()))
+
+	for _, key := range []gwu.Key{gwu.Key('S'), gwu.KeyA, gwu.KeyF5, keyQuestionMark} {
+		sl.handleKey(&testEvent{key: key})
+	}
+	assert.Equal(t, []string{"save all", "refresh"}, pressed)
+}
+
+func TestGuiBuilder_RegisterShortcutHelp(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+	win := g.MakeWindow("main", "Main", Options{})
+	g.BindShortcut(win, gwu.Key('N'), "New item", func(e gwu.Event) {})
+	g.RegisterShortcutHelp(win)
+	g.RegisterShortcutHelp(win)
+	assert.Equal(t, 2, win.CompsCount())
+	sl := win.CompAt(0).(*shortcutListener)
+	assert.Equal(t, gwu.DisplayNone, sl.help.Style().Display())
+
+	// shortcuts bound after registering the help are listed too
+	g.BindShortcut(win, gwu.KeyDel, "Delete item", func(e gwu.Event) {})
+
+	e := &testEvent{key: keyQuestionMark}
+	sl.handleKey(e)
+	assert.Equal(t, "", sl.help.Style().Display())
+	assert.Equal(t, []gwu.Comp{sl.help}, e.dirty)
+	table := sl.help.CompAt(1).(gwu.Table)
+	for row, want := range [][2]string{{"N", "New item"}, {"Delete", "Delete item"}, {"?", "Show or hide this help"}} {
+		assert.Equal(t, want[0], table.CompAt(row, 0).(gwu.Label).Text())
+		assert.Equal(t, want[1], table.CompAt(row, 1).(gwu.Label).Text())
+	}
+
+	for _, tt := range []struct {
+		key     gwu.Key
+		display string
+	}{
+		{keyQuestionMark, gwu.DisplayNone},
+		{keyQuestionMark, ""},
+		{gwu.KeyEscape, gwu.DisplayNone},
+		{gwu.KeyEscape, gwu.DisplayNone},
+	} {
+		sl.handleKey(&testEvent{key: tt.key})
+		assert.Equal(t, tt.display, sl.help.Style().Display())
+	}
+
+	// the recipes of the previous help are discarded when it is rebuilt
+	recipes := len(g.recipes)
+	sl.handleKey(&testEvent{key: keyQuestionMark})
+	sl.handleKey(&testEvent{key: keyQuestionMark})
+	sl.handleKey(&testEvent{key: keyQuestionMark})
+	assert.Equal(t, recipes, len(g.recipes))
+}
+
+func TestKeyName(t *testing.T) {
+	for key, want := range map[gwu.Key]string{
+		gwu.KeyA:        "A",
+		gwu.Key9:        "9",
+		gwu.KeyF12:      "F12",
+		gwu.KeyEscape:   "Esc",
+		keyQuestionMark: "?",
+		gwu.KeyNumLock:  "Key 144",
+	} {
+		assert.Equal(t, want, keyName(key))
+	}
+}
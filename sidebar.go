@@ -0,0 +1,130 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+const (
+	// SidebarWidth is the default width of sidebar navigations made by MakeSidebarNav.
+	SidebarWidth = "200px"
+	// SidebarActiveBackground is the background of the active item of sidebar navigations.
+	SidebarActiveBackground = "#DCE4F0"
+)
+
+const (
+	// sidebarToggleClass is the style class of the menu buttons of sidebar navigations, displayed on narrow screens.
+	sidebarToggleClass = "wgowut-sidebar-toggle"
+	// sidebarItemsClass is the style class of the item panels of sidebar navigations, hidden on narrow screens unless
+	// they have sidebarOpenClass.
+	sidebarItemsClass = "wgowut-sidebar-items"
+	sidebarOpenClass  = "wgowut-sidebar-open"
+)
+
+// NavItem is an item of a SidebarNav; OnSelect is called when it's clicked, after it's made the active item.
+type NavItem struct {
+	Text     string
+	OnSelect func(gwu.Event)
+}
+
+// SidebarNav is a vertical navigation panel of items, highlighting the active (last clicked) item. Create it with
+// MakeSidebarNav.
+type SidebarNav struct {
+	gwu.Panel
+	items  []NavItem
+	labels []gwu.Label
+	list   gwu.Panel
+	active int
+	open   bool // open tells if the items are shown on narrow screens
+}
+
+// MakeSidebarNav creates a sidebar navigation with items, from top to bottom. No item is active until one is clicked
+// or set with SetActive. The panel is made with MakePanel using options with LayoutVertical; Width defaults to
+// SidebarWidth, Background to ToolbarBackground and VAlign to VATop.
+//
+// With the mobile layout (see SetMobileLayout), the items collapse into a menu button on narrow screens, which shows
+// and hides them; selecting an item hides them again.
+func (g *GuiBuilder) MakeSidebarNav(items []NavItem, options Options) *SidebarNav {
+	options.Layout = LayoutVertical
+	if options.Width == "" {
+		options.Width = SidebarWidth
+	}
+	if options.Background == "" {
+		options.Background = ToolbarBackground
+	}
+	if options.VAlign == "" {
+		options.VAlign = gwu.VATop
+	}
+
+	sn := &SidebarNav{Panel: g.MakePanel(options), items: items, list: g.MakePanel(Options{Layout: LayoutVertical, Width: FullWidth}), active: -1}
+	if g.MobileLayout().MaxWidth > 0 {
+		toggle := g.MakeButton("☰ Menu", Options{})
+		toggle.Style().AddClass(sidebarToggleClass)
+		toggle.AddEHandlerFunc(sn.toggleHandler, gwu.ETypeClick)
+		sn.Add(toggle)
+		sn.list.Style().AddClass(sidebarItemsClass)
+	}
+	sn.Add(sn.list)
+
+	for i, item := range items {
+		label := g.MakeLabel(item.Text, Options{})
+		label.Style().SetPadding("6px 12px").Set("cursor", "pointer").Set("border-left", "3px solid transparent")
+		label.SetAttr("role", "link")
+		label.AddEHandlerFunc(sn.itemHandler(i), gwu.ETypeClick)
+		sn.list.Add(label)
+		sn.list.CellFmt(label).Style().SetFullWidth()
+		sn.labels = append(sn.labels, label)
+	}
+
+	return sn
+}
+
+// Active returns the index of the active item, or -1 if there is none.
+func (sn *SidebarNav) Active() int {
+	return sn.active
+}
+
+// SetActive highlights the item of index i as the active item; -1 (or any index out of range) clears the highlight.
+// OnSelect of the item is not called. Mark the sidebar dirty after calling this from an event handler.
+func (sn *SidebarNav) SetActive(i int) {
+	if sn.active >= 0 {
+		label := sn.labels[sn.active]
+		label.Style().SetBackground("").SetFontWeight("").Set("border-left", "3px solid transparent")
+		label.SetAttr("aria-current", "")
+	}
+
+	if i < 0 || i >= len(sn.labels) {
+		sn.active = -1
+		return
+	}
+	label := sn.labels[i]
+	label.Style().SetBackground(SidebarActiveBackground).SetFontWeight(gwu.FontWeightBold).Set("border-left", "3px solid "+gwu.ClrNavy)
+	label.SetAttr("aria-current", "page")
+	sn.active = i
+}
+
+func (sn *SidebarNav) itemHandler(i int) func(gwu.Event) {
+	return func(e gwu.Event) {
+		sn.SetActive(i)
+		sn.setOpen(false)
+		e.MarkDirty(sn)
+		if fn := sn.items[i].OnSelect; fn != nil {
+			fn(e)
+		}
+	}
+}
+
+// toggleHandler shows or hides the items on narrow screens.
+func (sn *SidebarNav) toggleHandler(e gwu.Event) {
+	sn.setOpen(!sn.open)
+	e.MarkDirty(sn.list)
+}
+
+// setOpen shows or hides the items on narrow screens.
+func (sn *SidebarNav) setOpen(open bool) {
+	sn.open = open
+	if open {
+		sn.list.Style().AddClass(sidebarOpenClass)
+	} else {
+		sn.list.Style().RemoveClass(sidebarOpenClass)
+	}
+}
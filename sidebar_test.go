@@ -0,0 +1,76 @@
+package wgowut
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeSidebarNav(t *testing.T) {
+	g := &GuiBuilder{}
+	var selected string
+	sel := func(name string) func(gwu.Event) { return func(gwu.Event) { selected = name } }
+	sn := g.MakeSidebarNav([]NavItem{{"Users", sel("users")}, {"Settings", sel("settings")}, {"Logs", nil}}, Options{})
+
+	assert.Equal(t, gwu.LayoutVertical, sn.Layout())
+	assert.Equal(t, SidebarWidth, sn.Style().Width())
+	assert.Equal(t, ToolbarBackground, sn.Style().Background())
+	assert.Equal(t, 1, sn.CompsCount()) // no menu button without the mobile layout
+	assert.Equal(t, 3, sn.list.CompsCount())
+	assert.Equal(t, "Settings", sn.list.CompAt(1).(gwu.Label).Text())
+	assert.Equal(t, -1, sn.Active())
+
+	e := &testEvent{etype: gwu.ETypeClick}
+	sn.itemHandler(1)(e)
+	assert.Equal(t, "settings", selected)
+	assert.Equal(t, 1, sn.Active())
+	assert.Equal(t, []gwu.Comp{sn}, e.dirty)
+	assert.Equal(t, SidebarActiveBackground, sn.labels[1].Style().Background())
+	assert.Equal(t, gwu.FontWeightBold, sn.labels[1].Style().FontWeight())
+	assert.Equal(t, "page", sn.labels[1].Attr("aria-current"))
+
+	// the highlight moves to the clicked item
+	sn.itemHandler(2)(&testEvent{etype: gwu.ETypeClick})
+	assert.Equal(t, 2, sn.Active())
+	assert.Equal(t, "", sn.labels[1].Style().Background())
+	assert.Equal(t, "", sn.labels[1].Style().FontWeight())
+	assert.Equal(t, "", sn.labels[1].Attr("aria-current"))
+	assert.Equal(t, SidebarActiveBackground, sn.labels[2].Style().Background())
+
+	sn.SetActive(-1)
+	assert.Equal(t, -1, sn.Active())
+	assert.Equal(t, "", sn.labels[2].Style().Background())
+}
+
+func TestSidebarNav_mobile(t *testing.T) {
+	g := &GuiBuilder{}
+	g.SetMobileLayout(MobileOptions{MaxWidth: 600})
+	sn := g.MakeSidebarNav([]NavItem{{"Users", nil}, {"Settings", nil}}, Options{Width: "150px"})
+
+	assert.Equal(t, "150px", sn.Style().Width())
+	assert.Equal(t, 2, sn.CompsCount())
+	toggle := sn.CompAt(0).(gwu.Button)
+	assert.Contains(t, renderHTML(toggle), sidebarToggleClass)
+	assert.Contains(t, renderHTML(sn.list), sidebarItemsClass)
+	assert.NotContains(t, renderHTML(sn.list), sidebarOpenClass)
+
+	e := &testEvent{etype: gwu.ETypeClick}
+	sn.toggleHandler(e)
+	assert.Equal(t, []gwu.Comp{sn.list}, e.dirty)
+	assert.Contains(t, renderHTML(sn.list), sidebarOpenClass)
+
+	// selecting an item hides the items
+	sn.itemHandler(0)(&testEvent{etype: gwu.ETypeClick})
+	assert.NotContains(t, renderHTML(sn.list), sidebarOpenClass)
+
+	sn.toggleHandler(&testEvent{etype: gwu.ETypeClick})
+	sn.toggleHandler(&testEvent{etype: gwu.ETypeClick})
+	assert.NotContains(t, renderHTML(sn.list), sidebarOpenClass)
+
+	var buf bytes.Buffer
+	g.MakeWindow("main", "Main", Options{}).RenderWin(gwu.NewWriter(&buf), gwu.NewServer("app", ""))
+	assert.Contains(t, buf.String(), "<style>.wgowut-sidebar-toggle {display: none} @media")
+	assert.Contains(t, buf.String(), ".wgowut-sidebar-items:not(.wgowut-sidebar-open) {display: none}")
+}
@@ -0,0 +1,100 @@
+package wgowut
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// Slider is a range input selecting an integer between a minimum and a maximum. Create it with MakeSlider.
+type Slider struct {
+	gwu.TextBox
+	min, max, step int
+	onChange       func(e gwu.Event, value int)
+}
+
+// MakeSlider creates a slider selecting values from min to max in steps of step (1 if not positive), set to initial.
+// It's rendered as an HTML range input, which sends its value to the server when the user releases it. The following
+// options are used:
+//
+// BorderWidth, BorderStyle, BorderColor, Width, Height, Color, Background, Enable, AutoFocus
+func (g *GuiBuilder) MakeSlider(min, max, step, initial int, options Options) *Slider {
+	if max < min {
+		max = min
+	}
+	if step <= 0 {
+		step = 1
+	}
+
+	sl := &Slider{TextBox: gwu.NewTextBox(""), min: min, max: max, step: step}
+	sl.SetAttr("min", strconv.Itoa(min))
+	sl.SetAttr("max", strconv.Itoa(max))
+	sl.SetAttr("step", strconv.Itoa(step))
+	sl.SetValue(initial)
+
+	setEnabled(sl, options.Enable)
+	setStyle(sl.Style(), g.styleOptions(options))
+	if options.AutoFocus {
+		autoFocus(sl)
+	}
+	sl.AddEHandlerFunc(sl.changeHandler, gwu.ETypeChange)
+
+	return sl
+}
+
+// Value returns the value of the slider.
+func (sl *Slider) Value() int {
+	value, _ := strconv.Atoi(sl.Text())
+	return value
+}
+
+// SetValue sets the value of the slider, clamped to its range and rounded to its steps. Mark the slider dirty after
+// calling this from an event handler.
+func (sl *Slider) SetValue(value int) {
+	sl.SetText(strconv.Itoa(sl.clamp(value)))
+}
+
+// OnChange sets the function called with the new value after the user moves the slider.
+func (sl *Slider) OnChange(fn func(e gwu.Event, value int)) {
+	sl.onChange = fn
+}
+
+// clamp returns the value of the range of the slider nearest to value. Like range inputs, the slider only has the
+// values of whole steps from min, so max itself is out of range if it's not one.
+func (sl *Slider) clamp(value int) int {
+	if value <= sl.min {
+		return sl.min
+	}
+	if value > sl.max {
+		value = sl.max
+	}
+	steps := (value - sl.min + sl.step/2) / sl.step
+	if value = sl.min + steps*sl.step; value > sl.max {
+		value -= sl.step
+	}
+	return value
+}
+
+func (sl *Slider) changeHandler(e gwu.Event) {
+	value, err := strconv.Atoi(sl.Text())
+	if err != nil {
+		value = sl.min
+	}
+	if clamped := sl.clamp(value); clamped != value || err != nil {
+		value = clamped
+		e.MarkDirty(sl)
+	}
+	sl.SetText(strconv.Itoa(value))
+
+	if sl.onChange != nil {
+		sl.onChange(e, value)
+	}
+}
+
+// Render renders the text box of the slider as a range input.
+func (sl *Slider) Render(w gwu.Writer) {
+	var buf bytes.Buffer
+	sl.TextBox.Render(gwu.NewWriter(&buf))
+	w.Write(bytes.Replace(buf.Bytes(), []byte(`<input type="text"`), []byte(`<input type="range"`), 1))
+}
@@ -0,0 +1,63 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeSlider(t *testing.T) {
+	g := &GuiBuilder{}
+	sl := g.MakeSlider(0, 100, 5, 42, Options{Width: "200px", Enable: EnableFalse})
+
+	assert.Equal(t, 40, sl.Value()) // rounded to the steps
+	assert.Equal(t, "200px", sl.Style().Width())
+	assert.False(t, sl.Enabled())
+	html := renderHTML(sl)
+	assert.Contains(t, html, `<input type="range"`)
+	assert.Contains(t, html, `min="0"`)
+	assert.Contains(t, html, `max="100"`)
+	assert.Contains(t, html, `step="5"`)
+	assert.Contains(t, html, `value="40"`)
+
+	for value, want := range map[int]int{-5: 0, 0: 0, 2: 0, 3: 5, 97: 95, 98: 100, 100: 100, 120: 100} {
+		sl.SetValue(value)
+		assert.Equal(t, want, sl.Value(), value)
+	}
+
+	// max is only a value of the slider if it's a whole step from min
+	sl = g.MakeSlider(1, 10, 4, 10, Options{})
+	assert.Equal(t, 9, sl.Value())
+	sl.SetValue(8)
+	assert.Equal(t, 9, sl.Value())
+	sl.SetValue(6)
+	assert.Equal(t, 5, sl.Value())
+
+	sl = g.MakeSlider(10, 0, 0, 5, Options{})
+	assert.Equal(t, 10, sl.Value())
+}
+
+func TestSlider_OnChange(t *testing.T) {
+	g := &GuiBuilder{}
+	sl := g.MakeSlider(0, 10, 1, 5, Options{})
+	var got []int
+	sl.OnChange(func(e gwu.Event, value int) { got = append(got, value) })
+
+	sl.SetText("7")
+	e := &testEvent{etype: gwu.ETypeChange}
+	sl.changeHandler(e)
+	assert.Equal(t, []int{7}, got)
+	assert.Empty(t, e.dirty)
+
+	// values sent out of range are clamped and re-rendered
+	sl.SetText("15")
+	sl.changeHandler(e)
+	assert.Equal(t, 10, sl.Value())
+	assert.Equal(t, []gwu.Comp{sl}, e.dirty)
+
+	sl.SetText("x")
+	sl.changeHandler(e)
+	assert.Equal(t, 0, sl.Value())
+	assert.Equal(t, []int{7, 10, 0}, got)
+}
@@ -0,0 +1,87 @@
+package wgowut
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// Sparkline defaults used by MakeSparkline for the options left blank.
+const (
+	SparklineWidth  = "100px"          // SparklineWidth is the default width of sparklines.
+	SparklineHeight = "20px"           // SparklineHeight is the default height of sparklines.
+	SparklineColor  = ProgressBarColor // SparklineColor is the default color of the lines of sparklines.
+)
+
+// Sparkline is a small line chart of a series of values without axes or labels, showing a trend at a glance. Create
+// it with MakeSparkline.
+type Sparkline struct {
+	gwu.HTML
+	values []float64
+	color  string
+}
+
+// MakeSparkline creates a sparkline of values drawn with inline SVG, small enough to sit in a table cell or next to a
+// label. The line spans the whole width, from the smallest value at the bottom to the largest at the top. Color is the
+// color of the line, Width and Height the size of the sparkline; they default to SparklineColor, SparklineWidth and
+// SparklineHeight. The Background and border options are also used.
+func (g *GuiBuilder) MakeSparkline(values []float64, options Options) *Sparkline {
+	sl := &Sparkline{HTML: gwu.NewHTML(""), color: options.Color}
+	if sl.color == "" {
+		sl.color = SparklineColor
+	}
+	if options.Width == "" {
+		options.Width = SparklineWidth
+	}
+	if options.Height == "" {
+		options.Height = SparklineHeight
+	}
+	options.Color = ""
+	setStyle(sl.Style(), g.styleOptions(options))
+	sl.Style().SetDisplay("inline-block").Set("vertical-align", "middle")
+
+	sl.Refresh(values)
+	return sl
+}
+
+// Values returns the values of the sparkline.
+func (sl *Sparkline) Values() []float64 {
+	return sl.values
+}
+
+// Refresh redraws the sparkline with values. Mark the sparkline dirty after calling this from an event handler.
+func (sl *Sparkline) Refresh(values []float64) {
+	sl.values = values
+	sl.SetHTML(sl.svg())
+}
+
+// svg returns the SVG image of the sparkline: a polyline in a 100x20 view box stretched to the size of the sparkline.
+func (sl *Sparkline) svg() string {
+	var points []string
+	if len(sl.values) > 0 {
+		min, max := sl.values[0], sl.values[0]
+		for _, v := range sl.values {
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+		for i, v := range sl.values {
+			x, y := 50.0, 10.0 // a single value is a dot in the middle, equal values a horizontal line
+			if len(sl.values) > 1 {
+				x = 100 * float64(i) / float64(len(sl.values)-1)
+			}
+			if max > min {
+				y = 19 - 18*(v-min)/(max-min)
+			}
+			points = append(points, fmt.Sprintf("%.2f,%.2f", x, y))
+		}
+	}
+
+	return fmt.Sprintf(`<svg viewBox="0 0 100 20" width="100%%" height="100%%" preserveAspectRatio="none">`+
+		`<polyline points="%s" fill="none" stroke="%s" stroke-width="1.5" stroke-linecap="round" vector-effect="non-scaling-stroke"/>`+
+		`</svg>`, strings.Join(points, " "), sl.color)
+}
@@ -0,0 +1,43 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeSparkline(t *testing.T) {
+	g := &GuiBuilder{}
+	sl := g.MakeSparkline([]float64{1, 3, 2}, Options{})
+
+	assert.Equal(t, []float64{1, 3, 2}, sl.Values())
+	assert.Equal(t, SparklineWidth, sl.Style().Width())
+	assert.Equal(t, SparklineHeight, sl.Style().Height())
+	assert.Equal(t, "", sl.Style().Color())
+	assert.Contains(t, sl.HTML.HTML(), `<polyline points="0.00,19.00 50.00,1.00 100.00,10.00" fill="none" stroke="`+SparklineColor+`"`)
+
+	tests := []struct {
+		name   string
+		values []float64
+		points string
+	}{
+		{"no values", nil, ""},
+		{"single value", []float64{5}, "50.00,10.00"},
+		{"equal values", []float64{5, 5}, "0.00,10.00 100.00,10.00"},
+		{"negative values", []float64{-2, 0, -1}, "0.00,19.00 50.00,1.00 100.00,10.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sl.Refresh(tt.values)
+			assert.Equal(t, tt.values, sl.Values())
+			assert.Contains(t, sl.HTML.HTML(), `<polyline points="`+tt.points+`"`)
+		})
+	}
+
+	sl = g.MakeSparkline(nil, Options{Color: gwu.ClrRed, Width: "60px", Height: "1em", Background: gwu.ClrWhite})
+	assert.Equal(t, "60px", sl.Style().Width())
+	assert.Equal(t, "1em", sl.Style().Height())
+	assert.Equal(t, gwu.ClrWhite, sl.Style().Background())
+	assert.Contains(t, sl.HTML.HTML(), `stroke="`+gwu.ClrRed+`"`)
+}
@@ -0,0 +1,147 @@
+package wgowut
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// ExportSpec produces the declarative layout read by ParseHTMLLayout from an existing component tree, so a layout can
+// be built in code, exported, hand-tuned and reloaded. Tables, panels (vertical and natural layouts become div,
+// horizontal layouts span), labels, buttons, text and password boxes, check boxes and list boxes are exported along
+// with the styles and attributes ParseHTMLLayout understands; other state like event handlers and ids is not. An error
+// is returned if the tree holds a component that can't be expressed in the layout.
+func (g *GuiBuilder) ExportSpec(root gwu.Comp) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeSpec(&buf, root, 0); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeSpec(buf *bytes.Buffer, comp gwu.Comp, depth int) error {
+	indent := strings.Repeat("\t", depth)
+
+	switch c := comp.(type) {
+	case gwu.Table:
+		fmt.Fprintf(buf, "%s<table%s>\n", indent, specStyle(c.Style(), c.CellPadding(), c.HAlign(), c.VAlign()))
+		for row, rows := 0, tableRows(c); row < rows; row++ {
+			fmt.Fprintf(buf, "%s\t<tr>\n", indent)
+			for col, cols := 0, tableCols(c, row); col < cols; col++ {
+				cellFmt := c.CellFmt(row, col)
+				attrs := specStyle(cellFmt.Style(), cssPixels(cellFmt.Style().Padding()), cellFmt.HAlign(), cellFmt.VAlign())
+				if span := c.ColSpan(row, col); span > 1 {
+					attrs += fmt.Sprintf(` colspan="%d"`, span)
+				}
+				if span := c.RowSpan(row, col); span > 1 {
+					attrs += fmt.Sprintf(` rowspan="%d"`, span)
+				}
+
+				child := c.CompAt(row, col)
+				if child == nil {
+					fmt.Fprintf(buf, "%s\t\t<td%s></td>\n", indent, attrs)
+					continue
+				}
+				fmt.Fprintf(buf, "%s\t\t<td%s>\n", indent, attrs)
+				if err := writeSpec(buf, child, depth+3); err != nil {
+					return err
+				}
+				fmt.Fprintf(buf, "%s\t\t</td>\n", indent)
+			}
+			fmt.Fprintf(buf, "%s\t</tr>\n", indent)
+		}
+		fmt.Fprintf(buf, "%s</table>\n", indent)
+	case gwu.Panel:
+		tag := "div"
+		if c.Layout() == gwu.LayoutHorizontal {
+			tag = "span"
+		}
+		fmt.Fprintf(buf, "%s<%s%s>\n", indent, tag, specStyle(c.Style(), c.CellPadding(), c.HAlign(), c.VAlign()))
+		for i := 0; i < c.CompsCount(); i++ {
+			if err := writeSpec(buf, c.CompAt(i), depth+1); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintf(buf, "%s</%s>\n", indent, tag)
+	case gwu.TextBox:
+		inputType := "text"
+		if isPasswBox(c) {
+			inputType = "password"
+		}
+		attrs := specStyle(c.Style(), 0, "", "") + specBool(!c.Enabled(), "disabled") + specBool(c.ReadOnly(), "readonly")
+		fmt.Fprintf(buf, "%s<input type=\"%s\" value=\"%s\"%s>\n", indent, inputType, html.EscapeString(c.Text()), attrs)
+	case gwu.CheckBox:
+		if rb, ok := c.(gwu.RadioButton); ok && rb.Group() != nil {
+			return fmt.Errorf("wgowut: can't export radio button %v", c.ID())
+		}
+		attrs := specStyle(c.Style(), 0, "", "") + specBool(!c.Enabled(), "disabled") + specBool(c.State(), "checked")
+		fmt.Fprintf(buf, "%s<input type=\"checkbox\" value=\"%s\"%s>\n", indent, html.EscapeString(c.Text()), attrs)
+	case gwu.Button:
+		fmt.Fprintf(buf, "%s<button%s>%s</button>\n", indent, specStyle(c.Style(), 0, "", ""), html.EscapeString(c.Text()))
+	case gwu.ListBox:
+		attrs := specStyle(c.Style(), 0, "", "") + specBool(!c.Enabled(), "disabled") + specBool(c.Multi(), "multiple")
+		if c.Rows() > 1 {
+			attrs += fmt.Sprintf(` size="%d"`, c.Rows())
+		}
+		fmt.Fprintf(buf, "%s<select%s>\n", indent, attrs)
+		for i, value := range c.Values() {
+			fmt.Fprintf(buf, "%s\t<option%s>%s</option>\n", indent, specBool(c.Selected(i), "selected"), html.EscapeString(value))
+		}
+		fmt.Fprintf(buf, "%s</select>\n", indent)
+	case gwu.Label:
+		fmt.Fprintf(buf, "%s<span%s>%s</span>\n", indent, specStyle(c.Style(), 0, "", ""), html.EscapeString(c.Text()))
+	default:
+		return fmt.Errorf("wgowut: can't export component %v of type %T", comp.ID(), comp)
+	}
+
+	return nil
+}
+
+// isPasswBox reports if the text box is a password box, which gwu only exposes in the rendered HTML.
+func isPasswBox(tb gwu.TextBox) bool {
+	var buf bytes.Buffer
+	tb.Render(gwu.NewWriter(&buf))
+	return bytes.Contains(buf.Bytes(), []byte(`type="password"`))
+}
+
+// specStyle returns the style attribute holding the style properties understood by parseStyle, or "" if none is set.
+func specStyle(style gwu.Style, padding int, halign gwu.HAlign, valign gwu.VAlign) string {
+	var decls []string
+	add := func(name, value string) {
+		if value != "" {
+			decls = append(decls, name+": "+value)
+		}
+	}
+
+	add("width", style.Width())
+	add("height", style.Height())
+	add("color", style.Color())
+	add("background", style.Background())
+	add("font-size", style.FontSize())
+	add("white-space", style.WhiteSpace())
+	if border := strings.TrimSpace(style.Border()); !strings.HasPrefix(border, "0px") {
+		add("border", border)
+	}
+	if padding != 0 {
+		add("padding", strconv.Itoa(padding)+"px")
+	}
+	add("text-align", string(halign))
+	add("vertical-align", string(valign))
+
+	if len(decls) == 0 {
+		return ""
+	}
+	return ` style="` + html.EscapeString(strings.Join(decls, "; ")) + `"`
+}
+
+// specBool returns the boolean attribute if set is true.
+func specBool(set bool, name string) string {
+	if set {
+		return " " + name
+	}
+	return ""
+}
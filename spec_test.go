@@ -0,0 +1,85 @@
+package wgowut
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_ExportSpec(t *testing.T) {
+	g := &GuiBuilder{}
+
+	root := g.MakePanel(Options{Layout: LayoutVertical, Background: "aqua", Width: FullWidth})
+	row := g.MakePanel(Options{Layout: LayoutHorizontal, CellPadding: 4})
+	g.AddCompsToPanel(row, g.MakeLabel("Name <a&b>", Options{Color: "red"}), g.MakeButton("Go", Options{}))
+
+	table := g.MakeTable(Options{Rows: 3, Cols: 2, CellPadding: 3, BorderWidth: 2, BorderStyle: gwu.BrdStyleDotted, BorderColor: "red"})
+	table.Add(g.MakeLabel("Header", Options{}), 0, 0)
+	g.FormatTableCell(table, 0, 0, Options{ColSpan: 2, HAlign: gwu.HACenter})
+	table.Add(g.MakeTextBox("bob", Options{ReadOnly: true}), 1, 0)
+	pb := gwu.NewPasswBox("")
+	pb.SetEnabled(false)
+	table.Add(pb, 1, 1)
+	cb := gwu.NewCheckBox("check me")
+	cb.SetState(true)
+	table.Add(cb, 2, 0)
+	lb := g.MakeListBox([]string{"a", "b"}, Options{Multi: true, Rows: 3})
+	lb.ClearSelected()
+	lb.SetSelected(1, true)
+	table.Add(lb, 2, 1)
+
+	g.AddCompsToPanel(root, row, table)
+
+	spec, err := g.ExportSpec(root)
+	assert.NoError(t, err)
+	for _, want := range []string{
+		`<div style="width: 100%; background: aqua">`,
+		`<span style="padding: 4px">`,
+		`<span style="color: red">Name &lt;a&amp;b&gt;</span>`,
+		`<button>Go</button>`,
+		`<table style="border: 2px dotted red; padding: 3px">`,
+		`<td style="text-align: center" colspan="2">`,
+		`<input type="text" value="bob" readonly>`,
+		`<input type="password" value="" disabled>`,
+		`<input type="checkbox" value="check me" checked>`,
+		`<select multiple size="3">`,
+		`<option selected>b</option>`,
+	} {
+		assert.Contains(t, string(spec), want)
+	}
+
+	// the spec round trips through ParseHTMLLayout
+	parsed, _, err := g.ParseHTMLLayout(bytes.NewReader(spec))
+	assert.NoError(t, err)
+	reexported, err := g.ExportSpec(parsed)
+	assert.NoError(t, err)
+	assert.Equal(t, string(spec), string(reexported))
+
+	parsedTable := parsed.(gwu.Panel).CompAt(1).(gwu.Table)
+	assert.Equal(t, 2, parsedTable.ColSpan(0, 0))
+	assert.Equal(t, true, parsedTable.CompAt(2, 0).(gwu.CheckBox).State())
+	assert.Equal(t, []int{1}, parsedTable.CompAt(2, 1).(gwu.ListBox).SelectedIndices())
+}
+
+func TestGuiBuilder_ExportSpec_unsupported(t *testing.T) {
+	g := &GuiBuilder{}
+	for _, comp := range []gwu.Comp{
+		gwu.NewTimer(0),
+		gwu.NewRadioButton("r", gwu.NewRadioGroup("g")),
+		gwu.NewTabPanel(),
+	} {
+		panel := g.MakePanel(Options{})
+		panel.Add(comp)
+		spec, err := g.ExportSpec(panel)
+		assert.Error(t, err)
+		assert.Nil(t, spec)
+	}
+
+	spec, err := g.ExportSpec(g.MakeLabel("only", Options{}))
+	assert.NoError(t, err)
+	assert.Equal(t, "<span>only</span>\n", string(spec))
+	assert.False(t, strings.Contains(string(spec), "style"))
+}
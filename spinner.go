@@ -0,0 +1,160 @@
+package wgowut
+
+import (
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+const (
+	// SpinnerSize is the default width and height of spinners made by MakeSpinner.
+	SpinnerSize = "16px"
+	// SpinnerPollInterval is how often a spinner checks if the functions run by BusyWhile returned.
+	SpinnerPollInterval = 250 * time.Millisecond
+)
+
+// busyJob is a function run by BusyWhile, with the components it disabled and their previous states.
+type busyJob struct {
+	comps   []gwu.HasEnabled
+	enabled []bool
+	done    int32 // done is set to 1 when the function returned
+}
+
+// Spinner is a "working…" indicator displayed while functions run by BusyWhile are running. Create it with
+// MakeSpinner.
+type Spinner struct {
+	gwu.Panel
+	timer gwu.Timer
+	jobs  []*busyJob
+}
+
+// MakeSpinner creates a hidden spinner: a spinning circle of the Width (SpinnerSize by default) and Color
+// (ProgressBarColor by default) of options, displayed by BusyWhile.
+func (g *GuiBuilder) MakeSpinner(options Options) *Spinner {
+	size, color := options.Width, options.Color
+	if size == "" {
+		size = SpinnerSize
+	}
+	if color == "" {
+		color = ProgressBarColor
+	}
+
+	sp := &Spinner{Panel: g.MakePanel(Options{Layout: LayoutNatural})}
+	circle := gwu.NewHTML(fmt.Sprintf(`<style>@keyframes wgowut-spin {to {transform: rotate(360deg)}}</style>`+
+		`<span style="display:inline-block;width:%[1]s;height:%[1]s;box-sizing:border-box;border:2px solid %[2]s;`+
+		`border-right-color:transparent;border-radius:50%%;animation:wgowut-spin 0.8s linear infinite"></span>`, size, color))
+	sp.SetAttr("role", "status")
+	sp.SetAttr("aria-label", "Working")
+	sp.Style().SetDisplay(gwu.DisplayNone)
+
+	sp.timer = gwu.NewTimer(SpinnerPollInterval)
+	sp.timer.SetRepeat(true)
+	sp.timer.SetActive(false)
+	sp.timer.AddEHandlerFunc(sp.pollHandler, gwu.ETypeStateChange)
+	g.AddCompsToPanel(sp, circle, sp.timer)
+
+	return sp
+}
+
+// Busy tells if functions run by BusyWhile are running.
+func (sp *Spinner) Busy() bool {
+	return len(sp.jobs) > 0
+}
+
+// BusyWhile runs fn in a new goroutine while the spinner of win (made by MakeSpinner) is displayed and comps are
+// disabled, preventing duplicate submits of long operations. Once fn returns, comps get back their enabled states and
+// are marked dirty with the spinner, which polls for it with a timer. A panic of fn is recovered and logged with its
+// stack, and restores comps like a return. If win has no spinner, fn is run in the calling goroutine, with comps
+// disabled.
+//
+// e is the event of the calling handler. gwu only updates the browser with the components marked dirty on the event
+// being handled, so BusyWhile marks comps and the spinner dirty with e: without it, the disabled components and the
+// spinner wouldn't be displayed, and the timer of the spinner, activated when it is rendered, would never poll.
+func (g *GuiBuilder) BusyWhile(e gwu.Event, win gwu.Window, comps []gwu.HasEnabled, fn func()) {
+	job := &busyJob{comps: comps, enabled: make([]bool, len(comps))}
+	for i, comp := range comps {
+		job.enabled[i] = comp.Enabled()
+		comp.SetEnabled(false)
+	}
+
+	sp := windowSpinner(win)
+	if sp == nil {
+		defer job.restore(nil)
+		runBusy(fn)
+		return
+	}
+
+	sp.jobs = append(sp.jobs, job)
+	sp.Style().SetDisplay("")
+	sp.timer.SetActive(true)
+	markEnabledDirty(e, comps)
+	e.MarkDirty(sp)
+
+	go func() {
+		defer atomic.StoreInt32(&job.done, 1)
+		runBusy(fn)
+	}()
+}
+
+// runBusy calls fn, recovering and logging its panics.
+func runBusy(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("wgowut: panic in busy function: %v\n%s", r, debug.Stack())
+		}
+	}()
+	fn()
+}
+
+// windowSpinner returns the first spinner in win, or nil.
+func windowSpinner(win gwu.Window) *Spinner {
+	var sp *Spinner
+	walkComps(win, func(c gwu.Comp) {
+		if s, ok := c.(*Spinner); ok && sp == nil {
+			sp = s
+		}
+	})
+	return sp
+}
+
+// pollHandler restores the components of the functions that returned, and hides the spinner once all returned.
+func (sp *Spinner) pollHandler(e gwu.Event) {
+	running := sp.jobs[:0]
+	for _, job := range sp.jobs {
+		if atomic.LoadInt32(&job.done) == 1 {
+			job.restore(e)
+		} else {
+			running = append(running, job)
+		}
+	}
+	sp.jobs = running
+
+	if len(sp.jobs) == 0 {
+		sp.Style().SetDisplay(gwu.DisplayNone)
+		sp.timer.SetActive(false)
+		e.MarkDirty(sp)
+	}
+}
+
+// restore gives the components of the job back their enabled states, marking them dirty if e is not nil.
+func (job *busyJob) restore(e gwu.Event) {
+	for i, comp := range job.comps {
+		comp.SetEnabled(job.enabled[i])
+	}
+	if e != nil {
+		markEnabledDirty(e, job.comps)
+	}
+}
+
+// markEnabledDirty marks the components of comps dirty.
+func markEnabledDirty(e gwu.Event, comps []gwu.HasEnabled) {
+	for _, comp := range comps {
+		if c, ok := comp.(gwu.Comp); ok {
+			e.MarkDirty(c)
+		}
+	}
+}
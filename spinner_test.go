@@ -0,0 +1,99 @@
+package wgowut
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeSpinner(t *testing.T) {
+	g := &GuiBuilder{}
+	sp := g.MakeSpinner(Options{Width: "24px", Color: gwu.ClrRed})
+
+	assert.Equal(t, gwu.DisplayNone, sp.Style().Display())
+	assert.False(t, sp.timer.Active())
+	assert.False(t, sp.Busy())
+	html := renderHTML(sp)
+	assert.Contains(t, html, "width:24px;height:24px")
+	assert.Contains(t, html, "border:2px solid "+gwu.ClrRed)
+}
+
+func TestGuiBuilder_BusyWhile(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "Main", Options{})
+	sp := g.MakeSpinner(Options{})
+	panel := g.MakePanel(Options{})
+	panel.Add(sp)
+	win.Add(panel)
+	save, cancel := g.MakeButton("Save", Options{}), g.MakeButton("Cancel", Options{})
+	cancel.SetEnabled(false)
+
+	release := make(chan struct{})
+	var ran int32
+	e := &testEvent{etype: gwu.ETypeClick}
+	g.BusyWhile(e, win, []gwu.HasEnabled{save, cancel}, func() {
+		<-release
+		atomic.StoreInt32(&ran, 1)
+	})
+	assert.False(t, save.Enabled())
+	assert.False(t, cancel.Enabled())
+	assert.Equal(t, "", sp.Style().Display())
+	assert.True(t, sp.timer.Active())
+	assert.True(t, sp.Busy())
+	assert.Equal(t, []gwu.Comp{save, cancel, sp}, e.dirty)
+
+	// polling while fn is running changes nothing
+	e = &testEvent{etype: gwu.ETypeStateChange}
+	sp.pollHandler(e)
+	assert.True(t, sp.Busy())
+	assert.Empty(t, e.dirty)
+
+	close(release)
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&sp.jobs[0].done) == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&ran))
+
+	e = &testEvent{etype: gwu.ETypeStateChange}
+	sp.pollHandler(e)
+	assert.False(t, sp.Busy())
+	assert.True(t, save.Enabled())
+	assert.False(t, cancel.Enabled()) // the previous state is restored
+	assert.Equal(t, gwu.DisplayNone, sp.Style().Display())
+	assert.False(t, sp.timer.Active())
+	assert.Equal(t, []gwu.Comp{save, cancel, sp}, e.dirty)
+}
+
+func TestGuiBuilder_BusyWhile_noSpinner(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "Main", Options{})
+	save := g.MakeButton("Save", Options{})
+
+	var enabledDuring bool
+	g.BusyWhile(&testEvent{etype: gwu.ETypeClick}, win, []gwu.HasEnabled{save}, func() { enabledDuring = save.Enabled() })
+	assert.False(t, enabledDuring)
+	assert.True(t, save.Enabled())
+}
+
+func TestGuiBuilder_BusyWhile_panic(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("main", "Main", Options{})
+	sp := g.MakeSpinner(Options{})
+	save := g.MakeButton("Save", Options{})
+	g.AddCompsToPanel(win, sp, save)
+
+	e := &testEvent{etype: gwu.ETypeClick, src: save}
+	g.BusyWhile(e, win, []gwu.HasEnabled{save}, func() { panic("boom") })
+	for atomic.LoadInt32(&sp.jobs[0].done) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	sp.pollHandler(&testEvent{etype: gwu.ETypeStateChange, src: sp.timer})
+	assert.False(t, sp.Busy())
+	assert.True(t, save.Enabled())
+
+	// without a spinner, the components are restored too
+	win = g.MakeWindow("other", "Other", Options{})
+	g.BusyWhile(e, win, []gwu.HasEnabled{save}, func() { panic("boom") })
+	assert.True(t, save.Enabled())
+}
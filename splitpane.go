@@ -0,0 +1,45 @@
+package wgowut
+
+import (
+	"strconv"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// MakeSplitPane creates a master/detail layout: a panel with first and second side by side, separated by a line. The
+// Ratio option is the percentage of the width taken by first (50 if not between 1 and 99), and LayoutVertical stacks
+// them instead, splitting the height. The panel is made with MakePanel using options; Width and Height default to full
+// size, so the panes fill the window, and VAlign defaults to VATop. Use a scrolling content (for example with an
+// overflow style) in panes that may outgrow their share.
+func (g *GuiBuilder) MakeSplitPane(first, second gwu.Comp, options Options) gwu.Panel {
+	ratio := options.Ratio
+	if ratio < 1 || ratio > 99 {
+		ratio = 50
+	}
+	if options.Layout != LayoutVertical {
+		options.Layout = LayoutHorizontal
+	}
+	if options.Width == "" {
+		options.Width = FullWidth
+	}
+	if options.Height == "" {
+		options.Height = "100%"
+	}
+	if options.VAlign == "" {
+		options.VAlign = gwu.VATop
+	}
+
+	split := g.MakePanel(options)
+	g.AddCompsToPanel(split, first, second)
+
+	firstCell, secondCell := split.CellFmt(first).Style(), split.CellFmt(second).Style()
+	if options.Layout == LayoutVertical {
+		firstCell.SetHeight(strconv.Itoa(ratio)+"%").Set("border-bottom", "1px solid "+gwu.ClrSilver)
+		secondCell.SetHeight(strconv.Itoa(100-ratio) + "%")
+	} else {
+		firstCell.SetWidth(strconv.Itoa(ratio)+"%").Set("border-right", "1px solid "+gwu.ClrSilver)
+		secondCell.SetWidth(strconv.Itoa(100-ratio) + "%")
+	}
+
+	return split
+}
@@ -0,0 +1,36 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeSplitPane(t *testing.T) {
+	g := &GuiBuilder{}
+	list, detail := g.MakeListBox([]string{"a", "b"}, Options{}), g.MakeLabel("details", Options{})
+	split := g.MakeSplitPane(list, detail, Options{Ratio: 30})
+
+	assert.Equal(t, gwu.LayoutHorizontal, split.Layout())
+	assert.Equal(t, "100%", split.Style().Width())
+	assert.Equal(t, "100%", split.Style().Height())
+	assert.Equal(t, gwu.VAlign(gwu.VATop), split.VAlign())
+	assert.Equal(t, gwu.Comp(list), split.CompAt(0))
+	assert.Equal(t, gwu.Comp(detail), split.CompAt(1))
+	assert.Equal(t, "30%", split.CellFmt(list).Style().Width())
+	assert.Equal(t, "70%", split.CellFmt(detail).Style().Width())
+	assert.Equal(t, "1px solid "+gwu.ClrSilver, split.CellFmt(list).Style().Get("border-right"))
+
+	split = g.MakeSplitPane(g.MakeLabel("top", Options{}), g.MakeLabel("bottom", Options{}),
+		Options{Layout: LayoutVertical, Height: "600px", VAlign: gwu.VAMiddle})
+	assert.Equal(t, gwu.LayoutVertical, split.Layout())
+	assert.Equal(t, "600px", split.Style().Height())
+	assert.Equal(t, gwu.VAlign(gwu.VAMiddle), split.VAlign())
+	assert.Equal(t, "50%", split.CellFmt(split.CompAt(0)).Style().Height())
+	assert.Equal(t, "50%", split.CellFmt(split.CompAt(1)).Style().Height())
+	assert.Equal(t, "1px solid "+gwu.ClrSilver, split.CellFmt(split.CompAt(0)).Style().Get("border-bottom"))
+
+	split = g.MakeSplitPane(g.MakeLabel("a", Options{}), g.MakeLabel("b", Options{}), Options{Ratio: 100})
+	assert.Equal(t, "50%", split.CellFmt(split.CompAt(0)).Style().Width())
+}
@@ -0,0 +1,82 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// StatusSlot is a slot of a StatusBar.
+type StatusSlot int
+
+// StatusSlot constants
+const (
+	StatusLeft StatusSlot = iota
+	StatusCenter
+	StatusRight
+)
+
+// StatusBar is a bar pinned to the bottom of the window with a label in each of its slots. Create it with
+// MakeStatusBar.
+type StatusBar struct {
+	gwu.Panel
+	labels [3]gwu.Label
+}
+
+// MakeStatusBar creates a full width status bar pinned to the bottom of the window, with left, center and right
+// slots; set their texts with SetStatus. The bar is made with MakePanel using options with LayoutHorizontal;
+// CellPadding defaults to ToolbarPadding and Background to ToolbarBackground, and the bar gets a top border unless a
+// BorderWidth is given. The bar covers the bottom of the window, so leave room for it below the content (for example
+// with a bottom padding on the window).
+func (g *GuiBuilder) MakeStatusBar(options Options) *StatusBar {
+	options.Layout = LayoutHorizontal
+	if options.CellPadding == 0 {
+		options.CellPadding = ToolbarPadding
+	}
+	if options.Background == "" {
+		options.Background = ToolbarBackground
+	}
+	options.Width = FullWidth
+
+	sb := &StatusBar{Panel: g.MakePanel(options)}
+	sb.Style().Set("position", "fixed").Set("left", "0").Set("bottom", "0").Set("z-index", "900")
+	if options.BorderWidth == 0 {
+		sb.Style().Set("border-top", "1px solid "+gwu.ClrSilver)
+	}
+
+	for i, halign := range []gwu.HAlign{gwu.HALeft, gwu.HACenter, gwu.HARight} {
+		sb.labels[i] = g.MakeLabel("", Options{WhiteSpace: gwu.WhiteSpaceNowrap})
+		sb.Add(sb.labels[i])
+		sb.CellFmt(sb.labels[i]).SetHAlign(halign)
+		sb.CellFmt(sb.labels[i]).Style().SetWidth("33%")
+	}
+	sb.labels[StatusLeft].SetAttr("role", "status")
+
+	return sb
+}
+
+// SetStatus sets the text of slot, and marks its label dirty if e is not nil.
+func (sb *StatusBar) SetStatus(e gwu.Event, slot StatusSlot, text string) {
+	label := sb.Label(slot)
+	if label == nil {
+		return
+	}
+	label.SetText(text)
+	if e != nil {
+		e.MarkDirty(label)
+	}
+}
+
+// Status returns the text of slot.
+func (sb *StatusBar) Status(slot StatusSlot) string {
+	if label := sb.Label(slot); label != nil {
+		return label.Text()
+	}
+	return ""
+}
+
+// Label returns the label of slot, for styling it, or nil if slot is invalid.
+func (sb *StatusBar) Label(slot StatusSlot) gwu.Label {
+	if slot < StatusLeft || slot > StatusRight {
+		return nil
+	}
+	return sb.labels[slot]
+}
@@ -0,0 +1,44 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeStatusBar(t *testing.T) {
+	g := &GuiBuilder{}
+	sb := g.MakeStatusBar(Options{Color: gwu.ClrGray})
+
+	assert.Equal(t, gwu.LayoutHorizontal, sb.Layout())
+	assert.Equal(t, ToolbarPadding, sb.CellPadding())
+	assert.Equal(t, ToolbarBackground, sb.Style().Background())
+	assert.Equal(t, gwu.ClrGray, sb.Style().Color())
+	assert.Equal(t, "fixed", sb.Style().Get("position"))
+	assert.Equal(t, "0", sb.Style().Get("bottom"))
+	assert.Equal(t, "1px solid "+gwu.ClrSilver, sb.Style().Get("border-top"))
+	assert.Equal(t, 3, sb.CompsCount())
+	for i, halign := range []gwu.HAlign{gwu.HALeft, gwu.HACenter, gwu.HARight} {
+		assert.Equal(t, halign, sb.CellFmt(sb.CompAt(i)).HAlign())
+		assert.Equal(t, gwu.Comp(sb.Label(StatusSlot(i))), sb.CompAt(i))
+	}
+
+	e := &testEvent{etype: gwu.ETypeClick}
+	sb.SetStatus(e, StatusRight, "3 items")
+	assert.Equal(t, "3 items", sb.Status(StatusRight))
+	assert.Equal(t, []gwu.Comp{sb.Label(StatusRight)}, e.dirty)
+
+	sb.SetStatus(nil, StatusLeft, "Ready")
+	assert.Equal(t, "Ready", sb.Status(StatusLeft))
+	assert.Equal(t, "", sb.Status(StatusCenter))
+
+	// invalid slots are ignored
+	sb.SetStatus(e, StatusSlot(5), "lost")
+	assert.Nil(t, sb.Label(StatusSlot(5)))
+	assert.Equal(t, "", sb.Status(StatusSlot(-1)))
+	assert.Len(t, e.dirty, 1)
+
+	sb = g.MakeStatusBar(Options{BorderWidth: 2, BorderStyle: gwu.BrdStyleSolid})
+	assert.Equal(t, "", sb.Style().Get("border-top"))
+}
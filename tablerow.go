@@ -0,0 +1,256 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// SetTableCellDefaults sets the options AddTableRow formats the cells of the rows it adds to table with. Like the
+// other formatting AddTableRow keeps, they are kept by g until ForgetTree is called for table.
+func (g *GuiBuilder) SetTableCellDefaults(table gwu.Table, options Options) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.tableRecipe(table, true).cellDefaults = &options
+}
+
+// SetTableHeader fills row 0 of table with bold labels of headers, replacing the components in it, and formats its
+// cells with headerOptions using FormatTableCell, for example with a Background. AddTableRow won't copy the header formatting to the first row added after it.
+func (g *GuiBuilder) SetTableHeader(table gwu.Table, headerOptions Options, headers ...string) {
+	table.EnsureCols(0, len(headers))
+	for col, header := range headers {
+		if old := table.CompAt(0, col); old != nil {
+			g.ForgetTree(old)
+		}
+		table.Add(g.makeHeaderLabel(header), 0, col)
+		g.FormatTableCell(table, 0, col, headerOptions)
+	}
+	g.markTableHeader(table, true)
+}
+
+// makeHeaderLabel makes a bold label, recorded so its clones are bold as well.
+func (g *GuiBuilder) makeHeaderLabel(text string) gwu.Label {
+	label := g.MakeLabel(text, Options{})
+	label.Style().SetFontWeight(gwu.FontWeightBold)
+	g.record(label, func(g *GuiBuilder) gwu.Comp { return g.makeHeaderLabel(text) })
+	return label
+}
+
+// markTableHeader records whether row 0 of table is a header set with SetTableHeader.
+func (g *GuiBuilder) markTableHeader(table gwu.Table, header bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if r := g.tableRecipe(table, header); r != nil {
+		r.header = header
+	}
+}
+
+// AddTableRow appends a row holding cells (nil cells are left empty) to table and returns its index. The row has as
+// many columns as cells, or as the previous last row if it has more. Its cells are formatted with FormatTableCell using
+// the options set with SetTableCellDefaults or, if there are none, the options the cells of the previous last row were
+// formatted with (without their RowSpan) unless it's the header set with SetTableHeader, so a table made by g keeps its
+// formatting as it grows. Cells are aligned with the HAlign of their column for tables made with MakeTableWithHeaders.
+func (g *GuiBuilder) AddTableRow(table gwu.Table, cells ...gwu.Comp) int {
+	row := tableRows(table)
+	cols := len(cells)
+	if row > 0 {
+		if prevCols := tableCols(table, row-1); prevCols > cols {
+			cols = prevCols
+		}
+	}
+	table.EnsureCols(row, cols)
+
+	for col, cell := range cells {
+		if cell != nil {
+			table.Add(cell, row, col)
+		}
+	}
+
+	for col, options := range g.newRowFmts(table, row-1, cols) {
+		g.FormatTableCell(table, row, col, options)
+	}
+	g.reapplyRowFmts(table)
+
+	return row
+}
+
+// newRowFmts returns the options of the cells of a row added after prevRow to table, by column.
+func (g *GuiBuilder) newRowFmts(table gwu.Table, prevRow, cols int) map[int]Options {
+	fmts := make(map[int]Options)
+	r := g.recipe(table)
+	if r == nil {
+		return fmts
+	}
+
+	if r.cellDefaults != nil {
+		for col := 0; col < cols; col++ {
+			fmts[col] = *r.cellDefaults
+		}
+	} else if prevRow != 0 || !r.header {
+		for _, f := range r.cellFmts {
+			if f.row == prevRow && f.col < cols {
+				options := f.options
+				options.RowSpan = 0
+				fmts[f.col] = options
+			}
+		}
+	}
+
+	for col, config := range r.columns {
+		if col < cols && config.HAlign != "" {
+			options := fmts[col]
+			options.HAlign = config.HAlign
+			fmts[col] = options
+		}
+	}
+	return fmts
+}
+
+// RemoveTableRow removes the row from table, moving the rows below it up along with the formatting their cells were
+// given with FormatTableCell and the merges of MergeCells (other cell formatting is lost, as gwu can only clear a
+// table). Merges spanning the row lose a row. The components of the removed row are discarded with ForgetTree. Out of
+// range rows are ignored.
+func (g *GuiBuilder) RemoveTableRow(table gwu.Table, row int) {
+	rows := tableRows(table)
+	if row < 0 || row >= rows {
+		return
+	}
+
+	comps := make([][]gwu.Comp, 0, rows-1)
+	for r := 0; r < rows; r++ {
+		rowComps := make([]gwu.Comp, tableCols(table, r))
+		for col := range rowComps {
+			rowComps[col] = table.CompAt(r, col)
+		}
+		if r != row {
+			comps = append(comps, rowComps)
+			continue
+		}
+		for _, c := range rowComps {
+			if c != nil {
+				g.ForgetTree(c)
+			}
+		}
+	}
+
+	table.Clear()
+	for r, rowComps := range comps {
+		table.EnsureCols(r, len(rowComps))
+		for col, c := range rowComps {
+			if c != nil {
+				table.Add(c, r, col)
+			}
+		}
+	}
+
+	for _, f := range g.removeCellFmtRow(table, row) {
+		g.applyCellFmt(table, f.row, f.col, f.options)
+	}
+	for _, m := range g.removeMergeRow(table, row) {
+		g.applyMerge(table, m)
+	}
+	g.reapplyRowFmts(table)
+}
+
+// StripeTable sets the background of the odd rows of table (the second, fourth and so on) to color, for readability
+// of wide tables, and clears it for the even rows; an empty color removes the stripes. Cell backgrounds take precedence
+// over the stripes. The stripes are reapplied by AddTableRow and RemoveTableRow, and a DataTable
+// stripes every other data row, starting with the first, whenever it's rendered. See also the StripeBackground option.
+func (g *GuiBuilder) StripeTable(table gwu.Table, color string) {
+	g.mu.Lock()
+	if r := g.tableRecipe(table, color != ""); r != nil {
+		r.stripe = color
+	}
+	g.mu.Unlock()
+
+	if dt, ok := table.(*DataTable); ok {
+		dt.render()
+		return
+	}
+	stripeRows(table, 0, tableRows(table), color)
+}
+
+// setColWidths records the column widths of table and sets them.
+func (g *GuiBuilder) setColWidths(table gwu.Table, widths []string) {
+	g.mu.Lock()
+	if r := g.tableRecipe(table, len(widths) > 0); r != nil {
+		r.colWidths = widths
+	}
+	g.mu.Unlock()
+
+	setColWidths(table, tableRows(table), widths)
+}
+
+// reapplyRowFmts reapplies the stripes set with StripeTable and the column widths of MakeTable to the rows of table.
+func (g *GuiBuilder) reapplyRowFmts(table gwu.Table) {
+	r := g.recipe(table)
+	if r == nil {
+		return
+	}
+	rows := tableRows(table)
+	if r.stripe != "" {
+		stripeRows(table, 0, rows, r.stripe)
+	}
+	setColWidths(table, rows, r.colWidths)
+}
+
+// setColWidths sets the width of the cells of the columns in the first rows of table.
+func setColWidths(table gwu.Table, rows int, widths []string) {
+	for row := 0; row < rows; row++ {
+		for col, width := range widths {
+			if cellFmt := table.CellFmt(row, col); cellFmt != nil && width != "" {
+				cellFmt.Style().SetWidth(width)
+			}
+		}
+	}
+}
+
+// tableStripe returns the background set with StripeTable for table, or "" if it has none.
+func (g *GuiBuilder) tableStripe(table gwu.Table) string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if r := g.recipes[table.ID()]; r != nil {
+		return r.stripe
+	}
+	return ""
+}
+
+// stripeRows sets the background of the rows from start to end, every other row starting with the second.
+func stripeRows(table gwu.Table, start, end int, color string) {
+	for row := start; row < end; row++ {
+		if (row-start)%2 == 1 {
+			table.RowFmt(row).Style().SetBackground(color)
+		} else {
+			table.RowFmt(row).Style().SetBackground("")
+		}
+	}
+}
+
+// removeCellFmtRow removes the recorded FormatTableCell calls (and header) of the row of table, moves those of the rows
+// below it up and returns the remaining calls.
+func (g *GuiBuilder) removeCellFmtRow(table gwu.Table, row int) []cellFmt {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	r := g.recipes[table.ID()]
+	if r == nil {
+		return nil
+	}
+	if row == 0 {
+		r.header = false
+	}
+
+	fmts := r.cellFmts[:0]
+	for _, f := range r.cellFmts {
+		if f.row == row {
+			continue
+		}
+		if f.row > row {
+			f.row--
+		}
+		fmts = append(fmts, f)
+	}
+	r.cellFmts = fmts
+	return append([]cellFmt(nil), fmts...)
+}
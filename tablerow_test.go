@@ -0,0 +1,234 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_AddTableRow(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults *Options
+		cells    int
+		wantCols int
+		wantBgs  []string
+	}{
+		{"copies previous row", nil, 2, 2, []string{"Red", "Blue"}},
+		{"defaults", &Options{Background: "Green"}, 2, 2, []string{"Green", "Green"}},
+		{"more cells", nil, 3, 3, []string{"Red", "Blue", ""}},
+		{"fewer cells", nil, 1, 2, []string{"Red", "Blue"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			g.EnableCloning()
+			table := g.MakeTable(Options{Rows: 1, Cols: 2})
+			g.FormatTableCell(table, 0, 0, Options{Background: "Red", RowSpan: 2})
+			g.FormatTableCell(table, 0, 1, Options{Background: "Blue"})
+			if tt.defaults != nil {
+				g.SetTableCellDefaults(table, *tt.defaults)
+			}
+
+			var cells []gwu.Comp
+			for i := 0; i < tt.cells; i++ {
+				cells = append(cells, g.MakeLabel("cell", Options{}))
+			}
+			row := g.AddTableRow(table, cells...)
+
+			assert.Equal(t, 1, row)
+			assert.Equal(t, 2, tableRows(table))
+			assert.Equal(t, tt.wantCols, tableCols(table, row))
+			for col, cell := range cells {
+				assert.Equal(t, cell, table.CompAt(row, col))
+			}
+			for col, bg := range tt.wantBgs {
+				assert.Equal(t, bg, table.CellFmt(row, col).Style().Background())
+			}
+			assert.True(t, table.RowSpan(row, 0) < 2)
+		})
+	}
+
+	// clones keep the defaults
+	g := &GuiBuilder{}
+	g.EnableCloning()
+	table := g.MakeTable(Options{})
+	g.SetTableCellDefaults(table, Options{Color: "Navy"})
+	clone := g.CloneTree(table).(gwu.Table)
+	row := g.AddTableRow(clone, g.MakeLabel("a", Options{}))
+	assert.Equal(t, 0, row)
+	assert.Equal(t, "Navy", clone.CellFmt(row, 0).Style().Color())
+
+	// tables not made by g are still grown
+	plain := gwu.NewTable()
+	assert.Equal(t, 0, g.AddTableRow(plain, gwu.NewLabel("a"), nil))
+	assert.Equal(t, 1, g.AddTableRow(plain, gwu.NewLabel("b")))
+	assert.Equal(t, 2, tableCols(plain, 1))
+}
+
+func TestGuiBuilder_RemoveTableRow(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+	table := g.MakeTable(Options{})
+	labels := make([]gwu.Label, 3)
+	for i, text := range []string{"a", "b", "c"} {
+		labels[i] = g.MakeLabel(text, Options{})
+		row := g.AddTableRow(table, labels[i])
+		g.FormatTableCell(table, row, 0, Options{Background: []string{"Red", "Green", "Blue"}[i]})
+	}
+
+	g.RemoveTableRow(table, -1)
+	g.RemoveTableRow(table, 3)
+	assert.Equal(t, 3, tableRows(table))
+
+	g.RemoveTableRow(table, 1)
+	assert.Equal(t, 2, tableRows(table))
+	assert.Equal(t, labels[0], table.CompAt(0, 0))
+	assert.Equal(t, labels[2], table.CompAt(1, 0))
+	assert.Equal(t, "Red", table.CellFmt(0, 0).Style().Background())
+	assert.Equal(t, "Blue", table.CellFmt(1, 0).Style().Background())
+	assert.Nil(t, labels[1].Parent())
+	assert.Nil(t, g.CloneTree(labels[1]))
+
+	// the recorded formatting follows the rows
+	clone := g.CloneTree(table).(gwu.Table)
+	assert.Equal(t, 2, tableRows(clone))
+	assert.Equal(t, "Blue", clone.CellFmt(1, 0).Style().Background())
+
+	// new rows copy the last row
+	row := g.AddTableRow(table, g.MakeLabel("d", Options{}))
+	assert.Equal(t, 2, row)
+	assert.Equal(t, "Blue", table.CellFmt(row, 0).Style().Background())
+
+	g.RemoveTableRow(table, 0)
+	g.RemoveTableRow(table, 0)
+	g.RemoveTableRow(table, 0)
+	assert.Equal(t, 0, tableRows(table))
+}
+
+func TestGuiBuilder_SetTableHeader(t *testing.T) {
+	g := &GuiBuilder{}
+	g.EnableCloning()
+	table := g.MakeTable(Options{Rows: 1, Cols: 1})
+	old := g.MakeLabel("old", Options{})
+	table.Add(old, 0, 0)
+
+	g.SetTableHeader(table, Options{Background: "Silver", HAlign: gwu.HACenter}, "Name", "Port")
+	assert.Equal(t, 2, tableCols(table, 0))
+	for col, text := range []string{"Name", "Port"} {
+		label := table.CompAt(0, col).(gwu.Label)
+		assert.Equal(t, text, label.Text())
+		assert.Equal(t, gwu.FontWeightBold, label.Style().FontWeight())
+		assert.Equal(t, "Silver", table.CellFmt(0, col).Style().Background())
+		assert.Equal(t, gwu.HAlign(gwu.HACenter), table.CellFmt(0, col).HAlign())
+	}
+	assert.Nil(t, old.Parent())
+	assert.Nil(t, g.CloneTree(old))
+
+	// the header formatting isn't copied to new rows, later rows are
+	row := g.AddTableRow(table, g.MakeLabel("alpha", Options{}), g.MakeLabel("80", Options{}))
+	assert.Equal(t, "", table.CellFmt(row, 0).Style().Background())
+	g.FormatTableCell(table, row, 1, Options{Color: "Navy"})
+	row = g.AddTableRow(table, g.MakeLabel("beta", Options{}), g.MakeLabel("443", Options{}))
+	assert.Equal(t, "Navy", table.CellFmt(row, 1).Style().Color())
+
+	// clones keep the header
+	clone := g.CloneTree(table).(gwu.Table)
+	assert.Equal(t, "Port", clone.CompAt(0, 1).(gwu.Label).Text())
+	assert.Equal(t, gwu.FontWeightBold, clone.CompAt(0, 1).Style().FontWeight())
+	g.RemoveTableRow(clone, 1)
+	g.RemoveTableRow(clone, 1)
+	row = g.AddTableRow(clone, g.MakeLabel("gamma", Options{}))
+	assert.Equal(t, "", clone.CellFmt(row, 0).Style().Background())
+
+	// removing the header row removes the header
+	g.RemoveTableRow(table, 0)
+	g.RemoveTableRow(table, 1)
+	row = g.AddTableRow(table, g.MakeLabel("gamma", Options{}))
+	assert.Equal(t, "", table.CellFmt(row, 0).Style().Background())
+	assert.Equal(t, "Navy", table.CellFmt(row, 1).Style().Color())
+}
+
+func TestGuiBuilder_StripeTable(t *testing.T) {
+	backgrounds := func(table gwu.Table) []string {
+		var bgs []string
+		for row := 0; row < tableRows(table); row++ {
+			bgs = append(bgs, table.RowFmt(row).Style().Background())
+		}
+		return bgs
+	}
+
+	g := &GuiBuilder{}
+
+	g.EnableCloning()
+	table := g.MakeTable(Options{Rows: 3, Cols: 1, StripeBackground: "Silver"})
+	assert.Equal(t, []string{"", "Silver", ""}, backgrounds(table))
+
+	g.AddTableRow(table, g.MakeLabel("d", Options{}))
+	assert.Equal(t, []string{"", "Silver", "", "Silver"}, backgrounds(table))
+	g.RemoveTableRow(table, 0)
+	assert.Equal(t, []string{"", "Silver", ""}, backgrounds(table))
+
+	clone := g.CloneTree(table).(gwu.Table)
+	assert.Equal(t, []string{"", "Silver", ""}, backgrounds(clone))
+
+	g.StripeTable(table, "")
+	assert.Equal(t, []string{"", "", ""}, backgrounds(table))
+	g.AddTableRow(table, g.MakeLabel("e", Options{}))
+	assert.Equal(t, []string{"", "", "", ""}, backgrounds(table))
+
+	// tables not made by g are striped once
+	plain := gwu.NewTable()
+	plain.EnsureSize(2, 1)
+	g.StripeTable(plain, "#eee")
+	assert.Equal(t, []string{"", "#eee"}, backgrounds(plain))
+
+	// data tables stripe every other data row
+	var data []testSortable
+	for _, name := range []string{"a", "b", "c"} {
+		data = append(data, testSortable{Name: name})
+	}
+	dt, err := g.MakeDataTable(data, Options{StripeBackground: "Silver", PageSize: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "Silver", "", "Silver", ""}, backgrounds(dt))
+
+	ft, err := g.MakeFilterableDataTable(data, Options{ColumnFilters: true})
+	assert.NoError(t, err)
+	g.StripeTable(ft.Table(), "Silver")
+	assert.Equal(t, []string{"", "", "Silver", "", "Silver"}, backgrounds(ft.Table()))
+}
+
+func TestGuiBuilder_MakeTable_colWidths(t *testing.T) {
+	widths := func(table gwu.Table, col int) []string {
+		var ws []string
+		for row := 0; row < tableRows(table); row++ {
+			ws = append(ws, table.CellFmt(row, col).Style().Width())
+		}
+		return ws
+	}
+
+	g := &GuiBuilder{}
+
+	g.EnableCloning()
+	table := g.MakeTable(Options{Rows: 2, Cols: 3, ColWidths: []string{"100px", "", "30%"}})
+	assert.Equal(t, []string{"100px", "100px"}, widths(table, 0))
+	assert.Equal(t, []string{"", ""}, widths(table, 1))
+	assert.Equal(t, []string{"30%", "30%"}, widths(table, 2))
+
+	g.AddTableRow(table, g.MakeLabel("a", Options{}))
+	assert.Equal(t, []string{"100px", "100px", "100px"}, widths(table, 0))
+	g.FormatTableCell(table, 0, 2, Options{Background: "Silver"})
+	g.RemoveTableRow(table, 1)
+	assert.Equal(t, []string{"30%", "30%"}, widths(table, 2))
+	assert.Equal(t, "Silver", table.CellFmt(0, 2).Style().Background())
+
+	clone := g.CloneTree(table).(gwu.Table)
+	assert.Equal(t, []string{"100px", "100px"}, widths(clone, 0))
+
+	dt, err := g.MakeDataTable([]testSortable{{Name: "a"}, {Name: "b"}}, Options{ColWidths: []string{"5em"}, PageSize: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"5em", "5em", "5em", ""}, widths(dt, 0))
+	dt.SetPage(0)
+	assert.Equal(t, []string{"5em", "5em", "5em", ""}, widths(dt, 0))
+}
@@ -0,0 +1,173 @@
+package wgowut
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// TagInput is a gwu.Panel created by GuiBuilder.MakeTagInput. Entries typed into its text box become removable
+// pill badges. The exported fields can be set after creation:
+//
+// MaxTags limits the number of tags, 0 means no limit. Suggest, if set, is called with the current text as the user
+// types and the returned values are offered in a list below the text box.
+type TagInput struct {
+	gwu.Panel
+	MaxTags int
+	Suggest func(prefix string) []string
+
+	tags        []string
+	tagsPanel   gwu.Panel
+	tb          gwu.TextBox
+	suggestions gwu.ListBox
+	errLabel    gwu.Label
+}
+
+// ErrDuplicateTag is returned by TagInput.AddTag when the tag is already present.
+var ErrDuplicateTag = errors.New("wgowut: duplicate tag")
+
+// MakeTagInput creates a TagInput with the initial tags. Entries are added when Enter or a comma is typed, multiple
+// entries can be separated by commas. The text box keeps its text when it loses focus, so picking a suggestion doesn't
+// add the typed prefix too. The following options are used for the panel:
+//
+// CellPadding, HAlign, Valign, WhiteSpace, BorderStyle, BorderWidth, BorderColor, Width, Height, Color, Background
+func (g *GuiBuilder) MakeTagInput(initial []string, options Options) *TagInput {
+	options.Layout = LayoutHorizontal
+
+	ti := &TagInput{
+		Panel:       g.MakePanel(options),
+		tagsPanel:   g.MakePanel(Options{Layout: LayoutHorizontal, CellPadding: 2}),
+		tb:          g.MakeTextBox("", Options{}),
+		suggestions: g.MakeListBox(nil, Options{Rows: 5}),
+		errLabel:    g.MakeLabel("", Options{Color: gwu.ClrRed}),
+	}
+
+	for _, tag := range initial {
+		ti.AddTag(tag)
+	}
+
+	ti.tb.AddSyncOnETypes(gwu.ETypeKeyUp)
+	ti.tb.AddEHandlerFunc(ti.handleKeyUp, gwu.ETypeKeyUp)
+
+	ti.suggestions.Style().SetDisplay("none")
+	ti.suggestions.AddEHandlerFunc(ti.handleSuggestion, gwu.ETypeChange)
+
+	entry := g.MakePanel(Options{})
+	g.AddCompsToPanel(entry, ti.tb, ti.suggestions)
+
+	g.AddCompsToPanel(ti.Panel, ti.tagsPanel, entry, ti.errLabel)
+
+	return ti
+}
+
+// Tags returns a copy of the current tags in order.
+func (ti *TagInput) Tags() []string {
+	return append([]string(nil), ti.tags...)
+}
+
+// AddTag adds the tag as a pill badge. Blank tags are ignored, an error is returned if the tag is already present or
+// MaxTags would be exceeded. Mark the TagInput dirty if this is called from an event handler.
+func (ti *TagInput) AddTag(tag string) error {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return nil
+	}
+	for _, existing := range ti.tags {
+		if existing == tag {
+			return ErrDuplicateTag
+		}
+	}
+	if ti.MaxTags > 0 && len(ti.tags) >= ti.MaxTags {
+		return fmt.Errorf("wgowut: at most %d tags are allowed", ti.MaxTags)
+	}
+
+	ti.tags = append(ti.tags, tag)
+	ti.tagsPanel.Add(ti.makePill(tag))
+
+	return nil
+}
+
+// RemoveTag removes the tag and its pill badge, returning false if the tag is not present. Mark the TagInput
+// dirty if this is called from an event handler.
+func (ti *TagInput) RemoveTag(tag string) bool {
+	for i, existing := range ti.tags {
+		if existing == tag {
+			ti.tags = append(ti.tags[:i], ti.tags[i+1:]...)
+			ti.tagsPanel.Remove(ti.tagsPanel.CompAt(i))
+			return true
+		}
+	}
+	return false
+}
+
+func (ti *TagInput) makePill(tag string) gwu.Comp {
+	pill := gwu.NewHorizontalPanel()
+	pill.Style().SetBackground(gwu.ClrSilver).SetPadding2("1px", "6px", "1px", "6px").Set("border-radius", "10px")
+
+	remove := gwu.NewLabel("×")
+	remove.Style().SetCursor(gwu.CursorPointer).SetPaddingLeftPx(4)
+	remove.AddEHandlerFunc(func(e gwu.Event) {
+		ti.RemoveTag(tag)
+		ti.errLabel.SetText("")
+		e.MarkDirty(ti)
+	}, gwu.ETypeClick)
+
+	pill.Add(gwu.NewLabel(tag))
+	pill.Add(remove)
+
+	return pill
+}
+
+func (ti *TagInput) addTags(text string, e gwu.Event) {
+	ti.errLabel.SetText("")
+	for _, tag := range strings.Split(text, ",") {
+		if err := ti.AddTag(tag); err != nil {
+			ti.errLabel.SetText(err.Error())
+		}
+	}
+
+	ti.tb.SetText("")
+	ti.suggestions.SetValues(nil)
+	ti.suggestions.Style().SetDisplay("none")
+	e.MarkDirty(ti)
+	e.SetFocusedComp(ti.tb)
+}
+
+// handleKeyUp adds the typed entries on Enter, and those before the last comma when one is typed, keeping the text
+// after it. Otherwise it updates the suggestions.
+func (ti *TagInput) handleKeyUp(e gwu.Event) {
+	text := ti.tb.Text()
+	if e.KeyCode() == gwu.KeyEnter {
+		ti.addTags(text, e)
+		return
+	}
+	if i := strings.LastIndex(text, ","); i >= 0 {
+		ti.addTags(text[:i], e)
+		ti.tb.SetText(text[i+1:])
+		return
+	}
+
+	if ti.Suggest == nil {
+		return
+	}
+
+	var values []string
+	if text := strings.TrimSpace(ti.tb.Text()); text != "" {
+		values = ti.Suggest(text)
+	}
+	ti.suggestions.SetValues(values)
+	if len(values) == 0 {
+		ti.suggestions.Style().SetDisplay("none")
+	} else {
+		ti.suggestions.Style().SetDisplay("")
+	}
+	e.MarkDirty(ti.suggestions)
+}
+
+func (ti *TagInput) handleSuggestion(e gwu.Event) {
+	if value := ti.suggestions.SelectedValue(); value != "" {
+		ti.addTags(value, e)
+	}
+}
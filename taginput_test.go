@@ -0,0 +1,111 @@
+package wgowut
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeTagInput(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		initial  []string
+		maxTags  int
+		typed    string
+		wantTags []string
+		wantErr  bool
+	}{
+		{"add typed tags", []string{"a"}, 0, "b, c", []string{"a", "b", "c"}, false},
+		{"ignore blank and duplicate tags", []string{"a", "a", " "}, 0, "a,,", []string{"a"}, true},
+		{"enforce max tags", []string{"a"}, 2, "b,c", []string{"a", "b"}, true},
+		{"no tags", nil, 0, "", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			options := Options{CellPadding: 1, Background: gwu.ClrAqua}
+			got := g.MakeTagInput(tt.initial, options)
+			got.MaxTags = tt.maxTags
+
+			checkTableView(t, got.Panel.(gwu.TableView), options)
+			checkStyle(t, got.Style(), options)
+			assert.Equal(t, gwu.LayoutHorizontal, got.Layout())
+
+			got.tb.SetText(tt.typed)
+			e := &testEvent{etype: gwu.ETypeKeyUp, src: got.tb, key: gwu.KeyEnter}
+			got.handleKeyUp(e)
+
+			assert.Equal(t, tt.wantTags, got.Tags())
+			assert.Equal(t, len(tt.wantTags), got.tagsPanel.CompsCount())
+			assert.Equal(t, tt.wantErr, got.errLabel.Text() != "")
+			assert.Equal(t, "", got.tb.Text())
+			assert.Equal(t, []gwu.Comp{got}, e.dirty)
+			assert.Equal(t, gwu.Comp(got.tb), e.focused)
+		})
+	}
+}
+
+func TestTagInput_separator(t *testing.T) {
+	g := &GuiBuilder{}
+	ti := g.MakeTagInput(nil, Options{})
+
+	// typing a comma adds the entries before it, keeping the text after it; commas are found in the text, as their key
+	// code depends on the keyboard layout
+	ti.tb.SetText("a, b,c")
+	e := &testEvent{etype: gwu.ETypeKeyUp, src: ti.tb}
+	ti.handleKeyUp(e)
+	assert.Equal(t, []string{"a", "b"}, ti.Tags())
+	assert.Equal(t, "c", ti.tb.Text())
+	assert.Equal(t, []gwu.Comp{ti}, e.dirty)
+
+	// other keys don't add the text
+	ti.handleKeyUp(&testEvent{etype: gwu.ETypeKeyUp, src: ti.tb, key: gwu.KeyA + 2})
+	assert.Equal(t, []string{"a", "b"}, ti.Tags())
+	assert.Equal(t, "c", ti.tb.Text())
+}
+
+func TestTagInput_RemoveTag(t *testing.T) {
+	g := &GuiBuilder{}
+	ti := g.MakeTagInput([]string{"a", "b", "c"}, Options{})
+
+	assert.Equal(t, true, ti.RemoveTag("b"))
+	assert.Equal(t, false, ti.RemoveTag("missing"))
+	assert.Equal(t, []string{"a", "c"}, ti.Tags())
+	assert.Equal(t, 2, ti.tagsPanel.CompsCount())
+	assert.Equal(t, "c", ti.tagsPanel.CompAt(1).(gwu.Panel).CompAt(0).(gwu.Label).Text())
+}
+
+func TestTagInput_Suggest(t *testing.T) {
+	g := &GuiBuilder{}
+	ti := g.MakeTagInput(nil, Options{})
+	ti.Suggest = func(prefix string) []string {
+		var matches []string
+		for _, owner := range []string{"alice", "albert", "bob"} {
+			if strings.HasPrefix(owner, prefix) {
+				matches = append(matches, owner)
+			}
+		}
+		return matches
+	}
+
+	ti.tb.SetText("al")
+	e := &testEvent{etype: gwu.ETypeKeyUp, src: ti.tb}
+	ti.handleKeyUp(e)
+	assert.Equal(t, []string{"alice", "albert"}, ti.suggestions.Values())
+	assert.Equal(t, "", ti.suggestions.Style().Display())
+	assert.Equal(t, []gwu.Comp{ti.suggestions}, e.dirty)
+
+	// the click on a suggestion blurs the text box, which doesn't add the typed prefix
+	ti.suggestions.SetSelected(1, true)
+	ti.handleSuggestion(&testEvent{etype: gwu.ETypeChange, src: ti.suggestions})
+	assert.Equal(t, []string{"albert"}, ti.Tags())
+	assert.Equal(t, "", ti.tb.Text())
+	assert.Equal(t, "none", ti.suggestions.Style().Display())
+
+	ti.tb.SetText("zz")
+	ti.handleKeyUp(&testEvent{})
+	assert.Equal(t, "none", ti.suggestions.Style().Display())
+}
@@ -0,0 +1,44 @@
+package wgowut
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// templates holds the template build functions registered with DefineTemplate by name.
+var templates = struct {
+	sync.RWMutex
+	builds map[string]func(params map[string]string, b *GuiBuilder) gwu.Comp
+}{builds: make(map[string]func(params map[string]string, b *GuiBuilder) gwu.Comp)}
+
+// DefineTemplate registers build as the named template, so a repeated visual structure (like a card or a row) can be
+// defined once and created with Instantiate, or with a template element in ParseHTMLLayout. Templates are shared by
+// all builders. Defining a name again replaces the template, and a nil build removes it.
+func (g *GuiBuilder) DefineTemplate(name string, build func(params map[string]string, b *GuiBuilder) gwu.Comp) {
+	templates.Lock()
+	defer templates.Unlock()
+
+	if build == nil {
+		delete(templates.builds, name)
+		return
+	}
+	templates.builds[name] = build
+}
+
+// Instantiate builds a new component tree from the named template, passing params and g to its build function. Missing
+// params are read as "" by the build function. An error is returned if there is no such template.
+func (g *GuiBuilder) Instantiate(name string, params map[string]string) (gwu.Comp, error) {
+	templates.RLock()
+	build := templates.builds[name]
+	templates.RUnlock()
+
+	if build == nil {
+		return nil, fmt.Errorf("wgowut: unknown template %q", name)
+	}
+	if params == nil {
+		params = make(map[string]string)
+	}
+	return build(params, g), nil
+}
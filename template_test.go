@@ -0,0 +1,81 @@
+package wgowut
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_Instantiate(t *testing.T) {
+	g := &GuiBuilder{}
+	g.DefineTemplate("testCard", func(params map[string]string, b *GuiBuilder) gwu.Comp {
+		assert.Equal(t, g, b)
+		panel := b.MakePanel(Options{Layout: LayoutVertical})
+		b.AddLabelsToPanel(panel, Options{}, params["title"], params["status"])
+		return panel
+	})
+	defer g.DefineTemplate("testCard", nil)
+
+	tests := []struct {
+		name       string
+		template   string
+		params     map[string]string
+		wantErr    bool
+		wantLabels []string
+	}{
+		{"all params", "testCard", map[string]string{"title": "alpha", "status": "up"}, false, []string{"alpha", "up"}},
+		{"missing param", "testCard", map[string]string{"title": "beta"}, false, []string{"beta", ""}},
+		{"nil params", "testCard", nil, false, []string{"", ""}},
+		{"unknown template", "testMissing", nil, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp, err := g.Instantiate(tt.template, tt.params)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			panel := comp.(gwu.Panel)
+			assert.Equal(t, len(tt.wantLabels), panel.CompsCount())
+			for i, text := range tt.wantLabels {
+				assert.Equal(t, text, panel.CompAt(i).(gwu.Label).Text())
+			}
+		})
+	}
+
+	// each instance is a new tree
+	first, _ := g.Instantiate("testCard", nil)
+	second, _ := g.Instantiate("testCard", nil)
+	assert.NotEqual(t, first.ID(), second.ID())
+
+	g.DefineTemplate("testCard", nil)
+	_, err := g.Instantiate("testCard", nil)
+	assert.Error(t, err)
+}
+
+func TestGuiBuilder_ParseHTMLLayout_template(t *testing.T) {
+	g := &GuiBuilder{}
+	g.DefineTemplate("testRow", func(params map[string]string, b *GuiBuilder) gwu.Comp {
+		return b.MakeLabel(params["host"]+":"+params["port"], Options{})
+	})
+	defer g.DefineTemplate("testRow", nil)
+
+	root, byID, err := g.ParseHTMLLayout(strings.NewReader(`<div>
+		<template id="first" name="testRow" Host="alpha" port="80"></template>
+		<template name="testRow" name2="x"></template>
+	</div>`))
+	assert.NoError(t, err)
+
+	panel := root.(gwu.Panel)
+	assert.Equal(t, 2, panel.CompsCount())
+	assert.Equal(t, byID["first"], panel.CompAt(0))
+	assert.Equal(t, "alpha:80", panel.CompAt(0).(gwu.Label).Text())
+	assert.Equal(t, ":", panel.CompAt(1).(gwu.Label).Text())
+
+	_, _, err = g.ParseHTMLLayout(strings.NewReader(`<template name="testMissing"></template>`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package wgowut
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// Layouts of the values of time and datetime-local inputs, with and without seconds.
+const (
+	timeInputLayout        = "15:04"
+	timeInputLayoutSec     = "15:04:05"
+	dateTimeInputLayout    = "2006-01-02T15:04"
+	dateTimeInputLayoutSec = "2006-01-02T15:04:05"
+)
+
+// TimePicker is an HTML time or datetime-local input. Create it with MakeTimePicker or MakeDateTimePicker.
+type TimePicker struct {
+	gwu.TextBox
+	inputType string
+	layouts   [2]string
+	loc       *time.Location
+	date      time.Time // date is the date of the values of time inputs
+	onChange  func(e gwu.Event, t time.Time)
+}
+
+// MakeTimePicker creates a time input (hours and minutes) set to the time of day of initial. Time returns the picked
+// time of day on the date of initial, in its location; set another location with SetLocation. The following options
+// are used:
+//
+// BorderWidth, BorderStyle, BorderColor, Width, Height, FontSize, Color, Background, Enable, AutoFocus
+func (g *GuiBuilder) MakeTimePicker(initial time.Time, options Options) *TimePicker {
+	return g.makeTimePicker("time", [2]string{timeInputLayout, timeInputLayoutSec}, initial, options)
+}
+
+// MakeDateTimePicker creates a datetime-local input (date, hours and minutes) set to initial, in the location of
+// initial; set another location with SetLocation. It uses the same options as MakeTimePicker.
+func (g *GuiBuilder) MakeDateTimePicker(initial time.Time, options Options) *TimePicker {
+	return g.makeTimePicker("datetime-local", [2]string{dateTimeInputLayout, dateTimeInputLayoutSec}, initial, options)
+}
+
+func (g *GuiBuilder) makeTimePicker(inputType string, layouts [2]string, initial time.Time, options Options) *TimePicker {
+	tp := &TimePicker{TextBox: gwu.NewTextBox(""), inputType: inputType, layouts: layouts, loc: initial.Location()}
+	tp.SetTime(initial)
+
+	setEnabled(tp, options.Enable)
+	setStyle(tp.Style(), g.styleOptions(options))
+	g.enlargeTarget(tp)
+	if options.AutoFocus {
+		autoFocus(tp)
+	}
+	tp.AddEHandlerFunc(tp.changeHandler, gwu.ETypeChange)
+
+	return tp
+}
+
+// Time returns the picked time in the location of the picker, or the zero time if the input is empty.
+func (tp *TimePicker) Time() time.Time {
+	for _, layout := range tp.layouts {
+		t, err := time.ParseInLocation(layout, tp.Text(), tp.loc)
+		if err != nil {
+			continue
+		}
+		if tp.inputType == "time" {
+			y, m, d := tp.date.Date()
+			t = time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, tp.loc)
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// SetTime sets the picked time, converted to the location of the picker; the zero time empties the input. The time
+// of a time picker is picked on the date of t from now on. Mark the picker dirty after calling this from an event
+// handler.
+func (tp *TimePicker) SetTime(t time.Time) {
+	if t.IsZero() {
+		tp.SetText("")
+		return
+	}
+	t = t.In(tp.loc)
+	tp.date = t
+	tp.SetText(t.Format(tp.layouts[0]))
+}
+
+// Location returns the location of the picked times.
+func (tp *TimePicker) Location() *time.Location {
+	return tp.loc
+}
+
+// SetLocation sets the location the picked times are in, keeping the displayed value. Mark the picker dirty after
+// calling this from an event handler.
+func (tp *TimePicker) SetLocation(loc *time.Location) {
+	t := tp.Time()
+	tp.loc = loc
+	if !t.IsZero() {
+		y, m, d := t.Date()
+		tp.date = time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, loc)
+	}
+}
+
+// OnChange sets the function called with the picked time after the user changes it.
+func (tp *TimePicker) OnChange(fn func(e gwu.Event, t time.Time)) {
+	tp.onChange = fn
+}
+
+func (tp *TimePicker) changeHandler(e gwu.Event) {
+	if tp.onChange != nil {
+		tp.onChange(e, tp.Time())
+	}
+}
+
+// Render renders the text box of the picker as a time or datetime-local input.
+func (tp *TimePicker) Render(w gwu.Writer) {
+	var buf bytes.Buffer
+	tp.TextBox.Render(gwu.NewWriter(&buf))
+	w.Write(bytes.Replace(buf.Bytes(), []byte(`<input type="text"`), []byte(`<input type="`+tp.inputType+`"`), 1))
+}
@@ -0,0 +1,56 @@
+package wgowut
+
+import (
+	"testing"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeTimePicker(t *testing.T) {
+	g := &GuiBuilder{}
+	tokyo := time.FixedZone("JST", 9*60*60)
+	initial := time.Date(2024, 5, 1, 9, 30, 15, 0, tokyo)
+	tp := g.MakeTimePicker(initial, Options{Width: "100px"})
+
+	assert.Equal(t, "09:30", tp.Text())
+	assert.Equal(t, "100px", tp.Style().Width())
+	assert.Contains(t, renderHTML(tp), `<input type="time"`)
+	assert.Equal(t, tokyo, tp.Location())
+	assert.Equal(t, time.Date(2024, 5, 1, 9, 30, 0, 0, tokyo), tp.Time())
+
+	var got time.Time
+	tp.OnChange(func(e gwu.Event, t time.Time) { got = t })
+	tp.SetText("17:45:30") // browsers send seconds if the step is below a minute
+	tp.changeHandler(&testEvent{etype: gwu.ETypeChange})
+	assert.Equal(t, time.Date(2024, 5, 1, 17, 45, 30, 0, tokyo), got)
+
+	tp.SetLocation(time.UTC)
+	assert.Equal(t, "17:45:30", tp.Text())
+	assert.Equal(t, time.Date(2024, 5, 1, 17, 45, 30, 0, time.UTC), tp.Time())
+
+	tp.SetText("")
+	assert.True(t, tp.Time().IsZero())
+	tp.SetText("noon")
+	assert.True(t, tp.Time().IsZero())
+}
+
+func TestGuiBuilder_MakeDateTimePicker(t *testing.T) {
+	g := &GuiBuilder{}
+	tp := g.MakeDateTimePicker(time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC), Options{})
+
+	assert.Equal(t, "2024-05-01T09:30", tp.Text())
+	assert.Contains(t, renderHTML(tp), `<input type="datetime-local"`)
+
+	tp.SetText("2024-12-24T18:00")
+	assert.Equal(t, time.Date(2024, 12, 24, 18, 0, 0, 0, time.UTC), tp.Time())
+
+	// times are converted to the location of the picker
+	tp.SetTime(time.Date(2024, 6, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)))
+	assert.Equal(t, "2024-06-01T10:00", tp.Text())
+
+	tp.SetTime(time.Time{})
+	assert.Equal(t, "", tp.Text())
+	assert.True(t, tp.Time().IsZero())
+}
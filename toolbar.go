@@ -0,0 +1,52 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// Toolbar defaults used by MakeToolbar for the options left blank.
+const (
+	ToolbarPadding    = 4         // ToolbarPadding is the default cell padding of toolbars.
+	ToolbarBackground = "#F3F3F3" // ToolbarBackground is the default background of toolbars.
+)
+
+// MakeToolbar creates a full width horizontal panel holding items from left to right, meant to sit at the top of a
+// window. nil items are replaced with separators (thin vertical lines). The panel is made with MakePanel using options
+// with LayoutHorizontal and VAMiddle; CellPadding defaults to ToolbarPadding, Background to ToolbarBackground and Width
+// to FullWidth, and the bar gets a bottom border unless a BorderWidth is given.
+func (g *GuiBuilder) MakeToolbar(options Options, items ...gwu.Comp) gwu.Panel {
+	options.Layout = LayoutHorizontal
+	if options.VAlign == "" {
+		options.VAlign = gwu.VAMiddle
+	}
+	if options.CellPadding == 0 {
+		options.CellPadding = ToolbarPadding
+	}
+	if options.Background == "" {
+		options.Background = ToolbarBackground
+	}
+	if options.Width == "" {
+		options.Width = FullWidth
+	}
+	toolbar := g.MakePanel(options)
+	if options.BorderWidth == 0 {
+		toolbar.Style().Set("border-bottom", "1px solid "+gwu.ClrSilver)
+	}
+
+	for _, item := range items {
+		if item == nil {
+			item = makeToolbarSeparator()
+		}
+		toolbar.Add(item)
+	}
+	toolbar.AddHConsumer()
+
+	return toolbar
+}
+
+// makeToolbarSeparator returns a thin vertical line separating toolbar items.
+func makeToolbarSeparator() gwu.Label {
+	sep := gwu.NewLabel("")
+	sep.Style().SetDisplay(gwu.DisplayBlock).SetWidthPx(1).SetHeight("1.5em").SetBackground(gwu.ClrSilver)
+	return sep
+}
@@ -0,0 +1,42 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeToolbar(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    Options
+		padding    int
+		background string
+		border     string
+	}{
+		{"defaults", Options{}, ToolbarPadding, ToolbarBackground, "1px solid " + gwu.ClrSilver},
+		{"set options", Options{CellPadding: 8, Background: gwu.ClrNavy, BorderWidth: 1, BorderStyle: gwu.BrdStyleSolid}, 8, gwu.ClrNavy, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GuiBuilder{}
+			save, open := g.MakeButton("Save", Options{}), g.MakeButton("Open", Options{})
+			toolbar := g.MakeToolbar(tt.options, save, nil, open)
+
+			assert.Equal(t, gwu.LayoutHorizontal, toolbar.Layout())
+			assert.Equal(t, gwu.VAlign(gwu.VAMiddle), toolbar.VAlign())
+			assert.Equal(t, tt.padding, toolbar.CellPadding())
+			assert.Equal(t, tt.background, toolbar.Style().Background())
+			assert.Equal(t, "100%", toolbar.Style().Width())
+			assert.Equal(t, tt.border, toolbar.Style().Get("border-bottom"))
+
+			// items, a separator in place of nil and the space consumer
+			assert.Equal(t, 4, toolbar.CompsCount())
+			assert.Equal(t, gwu.Comp(save), toolbar.CompAt(0))
+			assert.Equal(t, "1px", toolbar.CompAt(1).Style().Width())
+			assert.Equal(t, gwu.Comp(open), toolbar.CompAt(2))
+			assert.Equal(t, "100%", toolbar.CellFmt(toolbar.CompAt(3)).Style().Width())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package wgowut
+
+import (
+	"github.com/icza/gowut/gwu"
+)
+
+// childComps returns the direct child components of comp. Tables, panels (including windows and tab bars), tab
+// panels, expanders and links are supported, other components have no children.
+func childComps(comp gwu.Comp) []gwu.Comp {
+	var children []gwu.Comp
+	add := func(c gwu.Comp) {
+		if c != nil {
+			children = append(children, c)
+		}
+	}
+
+	switch c := comp.(type) {
+	case gwu.Table:
+		for row, rows := 0, tableRows(c); row < rows; row++ {
+			for col, cols := 0, tableCols(c, row); col < cols; col++ {
+				add(c.CompAt(row, col))
+			}
+		}
+	case gwu.TabPanel:
+		children = childComps(c.TabBar())
+		for i := 0; i < c.CompsCount(); i++ {
+			add(c.CompAt(i))
+		}
+	case gwu.PanelView:
+		for i := 0; i < c.CompsCount(); i++ {
+			add(c.CompAt(i))
+		}
+	case gwu.Expander:
+		add(c.Header())
+		add(c.Content())
+	case gwu.Link:
+		add(c.Comp())
+	}
+
+	return children
+}
+
+// walkComps calls fn for comp and all of its descendants, parents before children.
+func walkComps(comp gwu.Comp, fn func(c gwu.Comp)) {
+	fn(comp)
+	for _, child := range childComps(comp) {
+		walkComps(child, fn)
+	}
+}
+
+// tableRows returns the number of rows of the table. gwu doesn't expose the table size, so it is found with a
+// binary search on RowFmt, which returns nil for rows outside of the table.
+func tableRows(table gwu.Table) int {
+	return sizeSearch(func(i int) bool { return table.RowFmt(i) != nil })
+}
+
+// tableCols returns the number of columns in the row of the table, found with a binary search on CellFmt.
+func tableCols(table gwu.Table, row int) int {
+	return sizeSearch(func(i int) bool { return table.CellFmt(row, i) != nil })
+}
+
+// sizeSearch returns the smallest index for which exists returns false, assuming exists is true for all smaller
+// indexes.
+func sizeSearch(exists func(i int) bool) int {
+	if !exists(0) {
+		return 0
+	}
+	hi := 2
+	for exists(hi - 1) {
+		hi *= 2
+	}
+	// exists(lo-1) is true and exists(hi-1) is false
+	lo := hi / 2
+	for lo < hi-1 {
+		mid := (lo + hi) / 2
+		if exists(mid - 1) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// eventRoot returns the root panel of the component tree of the source of e: the panel embedded by its window, as gwu
+// sets that as the parent of the components added to a window. It returns nil if e has no source or the root isn't a
+// panel.
+func eventRoot(e gwu.Event) gwu.Panel {
+	root := e.Src()
+	if root == nil {
+		return nil
+	}
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	panel, _ := root.(gwu.Panel)
+	return panel
+}
@@ -0,0 +1,76 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_childComps(t *testing.T) {
+	l := func() gwu.Comp { return gwu.NewLabel("label") }
+
+	table := gwu.NewTable()
+	tableComps := []gwu.Comp{l(), l(), l()}
+	table.EnsureSize(3, 5)
+	table.Add(tableComps[0], 0, 4)
+	table.Add(tableComps[1], 2, 0)
+	table.EnsureCols(1, 9)
+	table.Add(tableComps[2], 1, 8)
+
+	panel := gwu.NewPanel()
+	panelComps := []gwu.Comp{l(), l()}
+	panel.Add(panelComps[0])
+	panel.Add(panelComps[1])
+
+	tabPanel := gwu.NewTabPanel()
+	tabComps := []gwu.Comp{l(), l()}
+	tabPanel.Add(tabComps[0], tabComps[1])
+
+	expander := gwu.NewExpander()
+	header := l()
+	expander.SetHeader(header)
+
+	link := gwu.NewLink("link", "url")
+	linkComp := l()
+	link.SetComp(linkComp)
+
+	tests := []struct {
+		name string
+		comp gwu.Comp
+		want []gwu.Comp
+	}{
+		{"table", table, []gwu.Comp{tableComps[0], tableComps[2], tableComps[1]}},
+		{"empty table", gwu.NewTable(), nil},
+		{"panel", panel, panelComps},
+		{"window", gwu.NewWindow("name", "text"), nil},
+		{"tab panel", tabPanel, tabComps},
+		{"expander", expander, []gwu.Comp{header}},
+		{"link", link, []gwu.Comp{linkComp}},
+		{"label", l(), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, childComps(tt.comp))
+		})
+	}
+}
+
+func Test_walkComps(t *testing.T) {
+	inner := gwu.NewPanel()
+	label := gwu.NewLabel("label")
+	inner.Add(label)
+	outer := gwu.NewTable()
+	outer.Add(inner, 0, 0)
+
+	var got []gwu.Comp
+	walkComps(outer, func(c gwu.Comp) { got = append(got, c) })
+	assert.Equal(t, []gwu.Comp{outer, inner, label}, got)
+}
+
+func Test_sizeSearch(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 100, 1023, 1024, 1025} {
+		got := sizeSearch(func(i int) bool { return i < size })
+		assert.Equal(t, size, got)
+	}
+}
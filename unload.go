@@ -0,0 +1,46 @@
+package wgowut
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// unloadGuard is the hidden component of a window asking for confirmation before the page is left while isDirty
+// reports unsaved changes.
+type unloadGuard struct {
+	gwu.HTML
+	isDirty func() bool
+}
+
+// WarnOnUnload makes the browser ask for confirmation before win is closed, reloaded or navigated away from while
+// isDirty returns true, preventing the accidental loss of long form entries. isDirty is called on the server when the
+// window is rendered and shortly after the user types, changes or clicks something in it, so it sees the edits sent
+// to the server by event handlers (for example ETypeChange handlers of text boxes, or the values of a Form after
+// changes), and a save button clearing the dirty state is accounted for. Calling it again for the window replaces
+// isDirty.
+func (g *GuiBuilder) WarnOnUnload(win gwu.Window, isDirty func() bool) {
+	for i := 0; i < win.CompsCount(); i++ {
+		if ug, ok := win.CompAt(i).(*unloadGuard); ok {
+			ug.isDirty = isDirty
+			return
+		}
+	}
+
+	win.Add(&unloadGuard{HTML: gwu.NewHTML(""), isDirty: isDirty})
+}
+
+// Render renders the current dirty state and the script keeping it up to date and hooking beforeunload. The browser
+// only runs the script when the window is loaded; it refreshes the state by rendering the guard again in the
+// background, as beforeunload handlers can't wait for the server.
+func (ug *unloadGuard) Render(w gwu.Writer) {
+	w.Writess(`<span id="`, ug.ID().String(), `" style="display:none" data-dirty="`, strconv.FormatBool(ug.isDirty()), `">`)
+	w.Writes(fmt.Sprintf(`<script>(function(){var d=%t,t;function r(){clearTimeout(t);t=setTimeout(function(){`+
+		`var x=new XMLHttpRequest();x.onload=function(){d=x.responseText.indexOf('data-dirty="true"')>=0;};`+
+		`x.open('GET',_pathRenderComp+'?cid=%d',true);x.send();},500);}`+
+		`['input','change','click'].forEach(function(n){document.addEventListener(n,r,true);});`+
+		`window.addEventListener('beforeunload',function(e){if(d){e.preventDefault();e.returnValue='';return '';}});})();</script>`,
+		ug.isDirty(), int(ug.ID())))
+	w.Writes("</span>")
+}
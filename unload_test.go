@@ -0,0 +1,30 @@
+package wgowut
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_WarnOnUnload(t *testing.T) {
+	g := &GuiBuilder{}
+	win := g.MakeWindow("form", "Form", Options{})
+	dirty := false
+	g.WarnOnUnload(win, func() bool { return dirty })
+
+	assert.Equal(t, 1, win.CompsCount())
+	guard := win.CompAt(0).(*unloadGuard)
+	html := renderHTML(guard)
+	assert.Contains(t, html, `data-dirty="false"`)
+	assert.Contains(t, html, "var d=false,")
+	assert.Contains(t, html, "beforeunload")
+	assert.Contains(t, html, "?cid="+guard.ID().String())
+
+	dirty = true
+	assert.Contains(t, renderHTML(guard), `data-dirty="true"`)
+
+	// calling it again replaces isDirty
+	g.WarnOnUnload(win, func() bool { return false })
+	assert.Equal(t, 1, win.CompsCount())
+	assert.Contains(t, renderHTML(guard), `data-dirty="false"`)
+}
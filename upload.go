@@ -0,0 +1,171 @@
+package wgowut
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/icza/gowut/gwu"
+)
+
+const (
+	// uploadEndpoint is the name of the wgowut endpoint receiving the files of upload widgets (see endpointPath).
+	uploadEndpoint = "upload/"
+	// MaxUploadSize is the size limit of uploaded files in bytes.
+	MaxUploadSize = 32 << 20
+)
+
+// uploads holds the upload widgets by their tokens, which are part of their endpoint URLs.
+var uploads = struct {
+	sync.Mutex
+	byToken map[string]*FileUpload
+	once    sync.Once
+}{byToken: make(map[string]*FileUpload)}
+
+// FileUpload is an upload widget created by GuiBuilder.MakeFileUpload: a file input with an upload button and a
+// progress bar, followed by a label displaying the result of the last upload.
+type FileUpload struct {
+	gwu.Panel
+
+	token    string
+	url      string
+	cookie   string // cookie is the name of the session id cookie of the server
+	sessID   string // sessID is the id of the session of the builder, the uploads of other sessions are rejected
+	onUpload func(filename string, content []byte) error
+	view     *uploadView
+	result   gwu.Label
+
+	mu       sync.Mutex // mu guards the result of the last upload, set by the endpoint
+	lastName string
+	lastErr  error
+}
+
+// uploadView is the component of an upload widget rendering the file input, the upload button and the progress bar.
+// The browser posts the file to the endpoint of the widget and fires a state change event on the view when done.
+type uploadView struct {
+	gwu.HTML
+	url string
+}
+
+// MakeFileUpload creates an upload widget. The user picks a file and clicks Upload, the file is posted to the
+// endpoint of the widget (see URL) showing its progress, and onUpload is called with its base name and content; the
+// widget then displays "Uploaded <name>", or the error returned by onUpload in red. Files over MaxUploadSize are
+// rejected. onUpload is called in the goroutine serving the upload, not in an event handler.
+//
+// The endpoint is served under the app path of the server made by g with NewServer, or of the server of the session of
+// g (see NewSessionBuilder), or the root. Made by the builder of a session, the widget only accepts the uploads of
+// that session. The endpoint is unregistered by Close or ForgetTree. The following options are used for the panel:
+//
+// CellPadding, HAlign, VAlign, BorderWidth, BorderStyle, BorderColor, Width, Color, Background
+func (g *GuiBuilder) MakeFileUpload(options Options, onUpload func(filename string, content []byte) error) *FileUpload {
+	options.Layout = LayoutVertical
+	token := newToken()
+	fu := &FileUpload{
+		Panel:    g.MakePanel(options),
+		token:    token,
+		url:      endpointPath(g.appPath(), uploadEndpoint) + token,
+		cookie:   g.sessIDCookieName(),
+		onUpload: onUpload,
+		result:   g.MakeLabel("", Options{}),
+	}
+	if g.session != nil {
+		fu.sessID = g.session.ID()
+	}
+	fu.view = &uploadView{HTML: gwu.NewHTML(""), url: fu.url}
+	fu.view.AddEHandlerFunc(fu.handleDone, gwu.ETypeStateChange)
+	fu.result.SetAttr("role", "status")
+	g.AddCompsToPanel(fu.Panel, fu.view, fu.result)
+
+	uploads.once.Do(func() { endpoints.mux.HandleFunc("/"+uploadEndpoint, serveUpload) })
+	uploads.Lock()
+	uploads.byToken[fu.token] = fu
+	uploads.Unlock()
+
+	return fu
+}
+
+// Close unregisters the endpoint of the upload widget, for widgets of closed sessions or removed windows.
+func (fu *FileUpload) Close() {
+	uploads.Lock()
+	defer uploads.Unlock()
+
+	delete(uploads.byToken, fu.token)
+}
+
+func (fu *FileUpload) forget() {
+	fu.Close()
+}
+
+// URL returns the path of the endpoint of the upload widget, under the app path of its server.
+func (fu *FileUpload) URL() string {
+	return fu.url
+}
+
+func (v *uploadView) Render(w gwu.Writer) {
+	id := v.ID().String()
+	w.Writess(`<span id="`, id, `"><input type="file" id="`, id, `-f"> <button type="button" onclick="`)
+	w.Writees(fmt.Sprintf(`var f=document.getElementById('%[1]s-f').files[0];if(!f)return;`+
+		`var p=document.getElementById('%[1]s-p');p.value=0;p.style.display='';var x=new XMLHttpRequest();`+
+		`x.open('POST','%[2]s?name='+encodeURIComponent(f.name));`+
+		`x.upload.onprogress=function(e){if(e.lengthComputable)p.value=e.loaded*100/e.total;};`+
+		`x.onloadend=function(){se(null,%[3]d,%[1]s);};x.send(f);`, id, v.url, int(gwu.ETypeStateChange)))
+	w.Writess(`">Upload</button> <progress id="`, id, `-p" max="100" value="0" style="display:none"></progress></span>`)
+}
+
+// serveUpload receives the file of an upload widget and calls its onUpload.
+func serveUpload(w http.ResponseWriter, r *http.Request) {
+	uploads.Lock()
+	fu := uploads.byToken[strings.TrimPrefix(r.URL.Path, "/"+uploadEndpoint)]
+	uploads.Unlock()
+	if fu != nil && fu.sessID != "" {
+		if c, err := r.Cookie(fu.cookie); err != nil || c.Value != fu.sessID {
+			fu = nil
+		}
+	}
+	if fu == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := path.Base(strings.Replace(r.URL.Query().Get("name"), `\`, "/", -1))
+	content, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxUploadSize))
+	if err != nil {
+		err = fmt.Errorf("file too large or unreadable: %v", err)
+	} else if fu.onUpload != nil {
+		err = fu.onUpload(name, content)
+	}
+
+	fu.mu.Lock()
+	fu.lastName, fu.lastErr = name, err
+	fu.mu.Unlock()
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleDone displays the result of the last upload and resets the file input and the progress bar.
+func (fu *FileUpload) handleDone(e gwu.Event) {
+	fu.mu.Lock()
+	name, err := fu.lastName, fu.lastErr
+	fu.mu.Unlock()
+
+	switch {
+	case err != nil:
+		fu.result.SetText("Upload failed: " + err.Error())
+		fu.result.Style().SetColor(gwu.ClrRed)
+	case name != "":
+		fu.result.SetText("Uploaded " + name)
+		fu.result.Style().SetColor(gwu.ClrGreen)
+	}
+	e.MarkDirty(fu.view, fu.result)
+}
@@ -0,0 +1,98 @@
+package wgowut
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuiBuilder_MakeFileUpload(t *testing.T) {
+	g := &GuiBuilder{}
+	if _, err := g.newGwuServer(ServerConfig{AppName: "uploadtest"}); !assert.NoError(t, err) {
+		return
+	}
+	var gotName, gotContent string
+	fu := g.MakeFileUpload(Options{CellPadding: 2}, func(filename string, content []byte) error {
+		gotName, gotContent = filename, string(content)
+		if filename == "bad.txt" {
+			return errors.New("not allowed")
+		}
+		return nil
+	})
+	defer fu.Close()
+
+	assert.Equal(t, gwu.LayoutVertical, fu.Layout())
+	assert.Equal(t, 2, fu.CompsCount())
+	assert.True(t, strings.HasPrefix(fu.URL(), "/uploadtest/_wgowut/upload/"))
+	assert.Contains(t, renderHTML(fu.view), `<input type="file"`)
+	assert.Contains(t, renderHTML(fu.view), fu.URL())
+
+	var cookie *http.Cookie
+	upload := func(method, url, body string) int {
+		rec := httptest.NewRecorder()
+		r := httptest.NewRequest(method, url, strings.NewReader(body))
+		if cookie != nil {
+			r.AddCookie(cookie)
+		}
+		http.DefaultServeMux.ServeHTTP(rec, r)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, upload(http.MethodPost, fu.URL()+"?name=C%3A%5Cdocs%5Creport.csv", "a,b\n1,2\n"))
+	assert.Equal(t, "report.csv", gotName)
+	assert.Equal(t, "a,b\n1,2\n", gotContent)
+
+	e := &testEvent{etype: gwu.ETypeStateChange, src: fu.view}
+	fu.handleDone(e)
+	assert.Equal(t, "Uploaded report.csv", fu.result.Text())
+	assert.Equal(t, gwu.ClrGreen, fu.result.Style().Color())
+	assert.Equal(t, []gwu.Comp{fu.view, fu.result}, e.dirty)
+
+	assert.Equal(t, http.StatusBadRequest, upload(http.MethodPost, fu.URL()+"?name=bad.txt", "x"))
+	fu.handleDone(&testEvent{etype: gwu.ETypeStateChange, src: fu.view})
+	assert.Equal(t, "Upload failed: not allowed", fu.result.Text())
+	assert.Equal(t, gwu.ClrRed, fu.result.Style().Color())
+
+	assert.Equal(t, http.StatusMethodNotAllowed, upload(http.MethodGet, fu.URL(), ""))
+	assert.Equal(t, http.StatusNotFound, upload(http.MethodPost, "/uploadtest/_wgowut/upload/unknown", "x"))
+
+	// a second server gets its own endpoint
+	g2 := &GuiBuilder{}
+	if _, err := g2.newGwuServer(ServerConfig{AppName: "uploadtest2"}); !assert.NoError(t, err) {
+		return
+	}
+	other := g2.MakeFileUpload(Options{}, nil)
+	defer other.Close()
+	assert.True(t, strings.HasPrefix(other.URL(), "/uploadtest2/_wgowut/upload/"))
+	assert.Equal(t, http.StatusOK, upload(http.MethodPost, other.URL()+"?name=a.txt", "x"))
+
+	// without a server, the endpoint is served at the root
+	none := (&GuiBuilder{}).MakeFileUpload(Options{}, nil)
+	defer none.Close()
+	assert.True(t, strings.HasPrefix(none.URL(), "/_wgowut/upload/"))
+
+	fu.Close()
+	assert.Equal(t, http.StatusNotFound, upload(http.MethodPost, fu.URL(), "x"))
+
+	// the widgets of a session only accept the uploads of the session, until forgotten
+	b := NewSessionBuilder(newTestSession("s1"))
+	b.server = g.server
+	private := b.MakeFileUpload(Options{}, nil)
+	defer private.Close()
+	assert.True(t, strings.HasPrefix(private.URL(), "/uploadtest/_wgowut/upload/"))
+	assert.Equal(t, http.StatusNotFound, upload(http.MethodPost, private.URL()+"?name=a.txt", "x"))
+	cookie = &http.Cookie{Name: "gwu-sessid", Value: "s2"}
+	assert.Equal(t, http.StatusNotFound, upload(http.MethodPost, private.URL()+"?name=a.txt", "x"))
+	cookie.Value = "s1"
+	assert.Equal(t, http.StatusOK, upload(http.MethodPost, private.URL()+"?name=a.txt", "x"))
+
+	panel := g.MakePanel(Options{})
+	panel.Add(private)
+	b.ForgetTree(panel)
+	assert.Equal(t, http.StatusNotFound, upload(http.MethodPost, private.URL()+"?name=a.txt", "x"))
+}
@@ -0,0 +1,192 @@
+package wgowut
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// Validator checks the value of an input and returns an error describing the problem if the value is not valid.
+// Any func(string) error can be used as a custom Validator. Text and password boxes are validated by their text,
+// list boxes by their selected value and check boxes by "true" if checked and "" if not.
+type Validator func(value string) error
+
+// ErrRequired is returned by the Required validator.
+var ErrRequired = errors.New("required")
+
+// Required returns a Validator that fails if the value is blank.
+func Required() Validator {
+	return func(value string) error {
+		if strings.TrimSpace(value) == "" {
+			return ErrRequired
+		}
+		return nil
+	}
+}
+
+// MinLen returns a Validator that fails if the value has fewer than n characters.
+func MinLen(n int) Validator {
+	return func(value string) error {
+		if len([]rune(value)) < n {
+			return fmt.Errorf("must be at least %d characters", n)
+		}
+		return nil
+	}
+}
+
+// Regexp returns a Validator that fails with message if the value doesn't match re.
+func Regexp(re *regexp.Regexp, message string) Validator {
+	return func(value string) error {
+		if !re.MatchString(value) {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
+
+// Numeric returns a Validator that fails if the value is not blank and can't be parsed as a number. Combine it with
+// Required if a value must be given.
+func Numeric() Validator {
+	return func(value string) error {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.New("must be a number")
+		}
+		return nil
+	}
+}
+
+// inputValue returns the value of an input as seen by validators.
+func inputValue(input gwu.Comp) string {
+	switch in := input.(type) {
+	case gwu.CheckBox:
+		if in.State() {
+			return "true"
+		}
+		return ""
+	case gwu.ListBox:
+		return in.SelectedValue()
+	case gwu.HasText:
+		return in.Text()
+	}
+	return ""
+}
+
+// validate runs the validators in order against the current value of input and returns the first error.
+func validate(input gwu.Comp, validators []Validator) error {
+	value := inputValue(input)
+	for _, validator := range validators {
+		if err := validator(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddValidators attaches validators to the named field. The field is validated whenever its input changes and the
+// error of the first failing validator is displayed in a red label next to the input (in a third column of the form),
+// which describes the input for assistive technology, and the input is marked with aria-invalid.
+// Fields created with Required set have the Required validator attached automatically.
+func (f *Form) AddValidators(name string, validators ...Validator) {
+	input := f.inputs[name]
+	if input == nil || len(validators) == 0 {
+		return
+	}
+
+	if f.errLabels[name] == nil {
+		row, _ := f.CompIdx(input)
+		f.EnsureSize(len(f.fields), 3)
+		errLabel := gwu.NewLabel("")
+		errLabel.Style().SetColor(gwu.ClrRed)
+		errLabel.SetAttr("aria-live", "polite")
+		input.SetAttr("aria-describedby", errLabel.ID().String())
+		f.errLabels[name] = errLabel
+		f.Add(errLabel, row, 2)
+
+		etype := gwu.ETypeChange
+		if _, ok := input.(gwu.CheckBox); ok {
+			etype = gwu.ETypeClick
+		}
+		input.AddEHandlerFunc(f.validationHandler(name), etype)
+	}
+
+	f.validators[name] = append(f.validators[name], validators...)
+	f.updateSubmit(nil)
+}
+
+func (f *Form) validationHandler(name string) func(e gwu.Event) {
+	return func(e gwu.Event) {
+		f.ValidateField(name)
+		e.MarkDirty(f.errLabels[name])
+		f.updateSubmit(e)
+	}
+}
+
+// ValidateField validates the named field, updates its error label, and returns the error of the first failing
+// validator or nil.
+func (f *Form) ValidateField(name string) error {
+	err := validate(f.inputs[name], f.validators[name])
+	if errLabel := f.errLabels[name]; errLabel != nil {
+		if err != nil {
+			errLabel.SetText(err.Error())
+			f.inputs[name].SetAttr("aria-invalid", "true")
+		} else {
+			errLabel.SetText("")
+			f.inputs[name].SetAttr("aria-invalid", "")
+		}
+	}
+	return err
+}
+
+// FormValid validates every field with validators, updates all error labels, and reports if all fields are valid.
+// Mark the form dirty after calling this from an event handler so the error labels are displayed.
+func (f *Form) FormValid() bool {
+	valid := true
+	for _, field := range f.fields {
+		if f.ValidateField(field.Name) != nil {
+			valid = false
+		}
+	}
+	return valid
+}
+
+// SetSubmitButton designates btn as the submit button of the form. The button is only enabled while all validators
+// pass, and is re-evaluated (and marked dirty if its state changes) whenever a validated input changes. Error labels
+// are not displayed for fields the user hasn't changed yet.
+func (f *Form) SetSubmitButton(btn gwu.Button) {
+	f.submit = btn
+	f.updateSubmit(nil)
+}
+
+// valid reports if all validators pass without updating the error labels.
+func (f *Form) valid() bool {
+	for name, validators := range f.validators {
+		if validate(f.inputs[name], validators) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// updateSubmit enables or disables the submit button based on validity, marking it dirty if e is not nil and the
+// state changed.
+func (f *Form) updateSubmit(e gwu.Event) {
+	if f.submit == nil {
+		return
+	}
+	valid := f.valid()
+	if f.submit.Enabled() == valid {
+		return
+	}
+	f.submit.SetEnabled(valid)
+	if e != nil {
+		e.MarkDirty(f.submit)
+	}
+}
@@ -0,0 +1,124 @@
+package wgowut
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidators(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		validator Validator
+		valid     []string
+		invalid   []string
+	}{
+		{"Required", Required(), []string{"a", " a "}, []string{"", "  "}},
+		{"MinLen", MinLen(3), []string{"abc", "äöü", "abcd"}, []string{"", "ab", "äö"}},
+		{"Regexp", Regexp(regexp.MustCompile(`^[a-z]+@[a-z]+$`), "invalid email"), []string{"a@b"}, []string{"", "a@", "A@b"}},
+		{"Numeric", Numeric(), []string{"", "1", " -2.5 ", "1e3"}, []string{"abc", "1,5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, value := range tt.valid {
+				assert.NoError(t, tt.validator(value), value)
+			}
+			for _, value := range tt.invalid {
+				assert.Error(t, tt.validator(value), value)
+			}
+		})
+	}
+}
+
+func TestForm_FormValid(t *testing.T) {
+	g := &GuiBuilder{}
+	form := g.MakeForm(Options{},
+		Field{Name: "name", Label: "Name", Required: true, Validators: []Validator{MinLen(3)}},
+		Field{Name: "age", Label: "Age", Validators: []Validator{Numeric()}},
+		Field{Name: "role", Label: "Role", Kind: InputListBox, Values: []string{"admin"}, Required: true},
+		Field{Name: "terms", Label: "Terms", Kind: InputCheckBox, Required: true},
+		Field{Name: "notes", Label: "Notes"},
+	)
+
+	assert.Equal(t, false, form.FormValid())
+	assert.Equal(t, ErrRequired.Error(), form.errLabels["name"].Text())
+	assert.Equal(t, "", form.errLabels["age"].Text())
+	assert.Equal(t, "", form.errLabels["role"].Text())
+	assert.Equal(t, ErrRequired.Error(), form.errLabels["terms"].Text())
+	assert.Nil(t, form.errLabels["notes"])
+	assert.Equal(t, form.errLabels["name"], form.CompAt(0, 2))
+
+	form.TextBox("name").SetText("al")
+	form.TextBox("age").SetText("x")
+	assert.Error(t, form.ValidateField("name"))
+	assert.Equal(t, "must be at least 3 characters", form.errLabels["name"].Text())
+
+	form.TextBox("name").SetText("alice")
+	form.TextBox("age").SetText("30")
+	form.CheckBox("terms").SetState(true)
+	assert.Equal(t, true, form.FormValid())
+	for _, errLabel := range form.errLabels {
+		assert.Equal(t, "", errLabel.Text())
+	}
+
+	// validators added later are appended and unknown fields are ignored
+	form.AddValidators("notes", MinLen(1))
+	form.AddValidators("missing", Required())
+	assert.Equal(t, false, form.FormValid())
+	assert.Equal(t, form.errLabels["notes"], form.CompAt(4, 2))
+}
+
+func Test_inputValue(t *testing.T) {
+	cb := gwu.NewCheckBox("check")
+	lb := gwu.NewListBox([]string{"a", "b"})
+	lb.SetSelected(1, true)
+
+	assert.Equal(t, "", inputValue(cb))
+	cb.SetState(true)
+	assert.Equal(t, "true", inputValue(cb))
+	assert.Equal(t, "b", inputValue(lb))
+	assert.Equal(t, "text", inputValue(gwu.NewTextBox("text")))
+	assert.Equal(t, "", inputValue(gwu.NewPanel()))
+}
+
+func TestForm_SetSubmitButton(t *testing.T) {
+	g := &GuiBuilder{}
+	form := g.MakeForm(Options{},
+		Field{Name: "name", Label: "Name", Required: true},
+		Field{Name: "age", Label: "Age", Validators: []Validator{Numeric()}},
+	)
+	btn := g.MakeButton("Submit", Options{})
+	form.SetSubmitButton(btn)
+	assert.Equal(t, false, btn.Enabled())
+	assert.Equal(t, "", form.errLabels["name"].Text())
+
+	// a valid change enables the button and marks it dirty
+	form.TextBox("name").SetText("alice")
+	e := &testEvent{etype: gwu.ETypeChange, src: form.Input("name")}
+	form.validationHandler("name")(e)
+	assert.Equal(t, true, btn.Enabled())
+	assert.Equal(t, []gwu.Comp{form.errLabels["name"], btn}, e.dirty)
+
+	// an unchanged state doesn't mark the button dirty
+	e = &testEvent{etype: gwu.ETypeChange, src: form.Input("age")}
+	form.validationHandler("age")(e)
+	assert.Equal(t, []gwu.Comp{form.errLabels["age"]}, e.dirty)
+
+	// an invalid change disables it again
+	form.TextBox("age").SetText("old")
+	e = &testEvent{etype: gwu.ETypeChange, src: form.Input("age")}
+	form.validationHandler("age")(e)
+	assert.Equal(t, false, btn.Enabled())
+	assert.Equal(t, "must be a number", form.errLabels["age"].Text())
+	assert.Equal(t, []gwu.Comp{form.errLabels["age"], btn}, e.dirty)
+
+	// validators added later are taken into account
+	form.TextBox("age").SetText("30")
+	form.validationHandler("age")(&testEvent{})
+	assert.Equal(t, true, btn.Enabled())
+	form.AddValidators("name", MinLen(10))
+	assert.Equal(t, false, btn.Enabled())
+}
@@ -0,0 +1,100 @@
+// Package wgowutbench builds windows with thousands of components and very large tables, and measures the size of
+// their HTML and the cost of refreshing them after events, for benchmarks catching performance regressions of the
+// builder and its subsystems.
+package wgowutbench
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ddrake12/wgowut"
+	"github.com/ddrake12/wgowut/wgowuttest"
+	"github.com/icza/gowut/gwu"
+)
+
+// eraDirtyComps is the gwu event response action listing the components to re-render.
+const eraDirtyComps = "2"
+
+// LargeWindow is a window with a row of components for each of its buttons, made by NewLargeWindow.
+type LargeWindow struct {
+	gwu.Window
+	Buttons []gwu.Button // Buttons holds the button of each row, which sets the label of the row when clicked.
+}
+
+// NewLargeWindow builds a window named name with rows rows, each a horizontal panel holding a label, a text box and
+// a button, so a window of 1000 rows has over 4000 components.
+func NewLargeWindow(g *wgowut.GuiBuilder, name string, rows int) *LargeWindow {
+	lw := &LargeWindow{Window: g.MakeWindow(name, name, wgowut.Options{})}
+
+	for i := 0; i < rows; i++ {
+		row := g.MakePanel(wgowut.Options{Layout: wgowut.LayoutHorizontal, CellPadding: 2})
+		label := g.MakeLabel("Row "+strconv.Itoa(i), wgowut.Options{})
+		tb := g.MakeTextBox("", wgowut.Options{Width: "200px"})
+		btn := g.MakeButton("Apply", wgowut.Options{})
+		btn.AddEHandlerFunc(func(e gwu.Event) {
+			label.SetText(tb.Text())
+			e.MarkDirty(label)
+		}, gwu.ETypeClick)
+		g.AddCompsToPanel(row, label, tb, btn)
+		lw.Add(row)
+		lw.Buttons = append(lw.Buttons, btn)
+	}
+
+	return lw
+}
+
+// LargeTable builds a table of rows rows and cols columns with MakeTable, holding a label in each cell.
+func LargeTable(g *wgowut.GuiBuilder, rows, cols int) gwu.Table {
+	table := g.MakeTable(wgowut.Options{Rows: rows, Cols: cols, CellPadding: 2})
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			table.Add(g.MakeLabel(fmt.Sprintf("%d:%d", row, col), wgowut.Options{}), row, col)
+		}
+	}
+	return table
+}
+
+// HTMLSize returns the size in bytes of the HTML page of win.
+func HTMLSize(win gwu.Window) int {
+	var buf bytes.Buffer
+	win.RenderWin(gwu.NewWriter(&buf), gwu.NewServer("wgowutbench", ""))
+	return buf.Len()
+}
+
+// EventRefresh sends an event of etype fired by the component to the window, then re-renders the components the
+// event made dirty, like the browser does, and returns the size in bytes of their HTML.
+func EventRefresh(sess *wgowuttest.Session, winName string, id gwu.ID, etype gwu.EventType) (int, error) {
+	resp, err := sess.Event(winName, id, etype, "")
+	if err != nil {
+		return 0, err
+	}
+
+	size := 0
+	for _, dirty := range dirtyIDs(resp) {
+		html, err := sess.RenderComp(winName, dirty)
+		if err != nil {
+			return size, err
+		}
+		size += len(html)
+	}
+	return size, nil
+}
+
+// dirtyIDs returns the ids of the components to re-render listed by a gwu event response.
+func dirtyIDs(resp string) []gwu.ID {
+	var ids []gwu.ID
+	for _, action := range strings.Split(resp, ";") {
+		fields := strings.Split(action, ",")
+		if fields[0] != eraDirtyComps {
+			continue
+		}
+		for _, field := range fields[1:] {
+			if id, err := gwu.AtoID(field); err == nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
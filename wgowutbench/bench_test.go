@@ -0,0 +1,96 @@
+package wgowutbench
+
+import (
+	"testing"
+
+	"github.com/ddrake12/wgowut"
+	"github.com/ddrake12/wgowut/wgowuttest"
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLargeWindow(t *testing.T) {
+	lw := NewLargeWindow(wgowut.NewGuiBuilder(), "large", 100)
+	assert.Equal(t, 100, lw.CompsCount())
+	assert.Len(t, lw.Buttons, 100)
+	assert.Greater(t, HTMLSize(lw), 100*100)
+}
+
+func TestLargeTable(t *testing.T) {
+	table := LargeTable(wgowut.NewGuiBuilder(), 50, 4)
+	assert.Equal(t, "49:3", table.CompAt(49, 3).(gwu.Label).Text())
+}
+
+func TestDirtyIDs(t *testing.T) {
+	assert.Equal(t, []gwu.ID{12, 13}, dirtyIDs("2,12,13;3,12"))
+	assert.Empty(t, dirtyIDs("0"))
+	assert.Empty(t, dirtyIDs("1,main"))
+}
+
+func TestEventRefresh(t *testing.T) {
+	lw := NewLargeWindow(wgowut.NewGuiBuilder(), "large", 10)
+	server, err := wgowuttest.NewServer(func(server gwu.Server) { server.AddWin(lw) })
+	if !assert.NoError(t, err) {
+		return
+	}
+	sess := server.NewSession()
+
+	size, err := EventRefresh(sess, "large", lw.Buttons[3].ID(), gwu.ETypeClick)
+	assert.NoError(t, err)
+	assert.Greater(t, size, 0)
+
+	_, err = EventRefresh(sess, "missing", lw.Buttons[3].ID(), gwu.ETypeClick)
+	assert.Error(t, err)
+}
+
+func BenchmarkNewLargeWindow(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NewLargeWindow(wgowut.NewGuiBuilder(), "large", 1000)
+	}
+}
+
+func BenchmarkLargeWindowHTML(b *testing.B) {
+	lw := NewLargeWindow(wgowut.NewGuiBuilder(), "large", 1000)
+	b.ResetTimer()
+
+	size := 0
+	for i := 0; i < b.N; i++ {
+		size = HTMLSize(lw)
+	}
+	b.ReportMetric(float64(size), "html-bytes")
+}
+
+func BenchmarkLargeTable(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LargeTable(wgowut.NewGuiBuilder(), 1000, 20)
+	}
+}
+
+func BenchmarkLargeTableHTML(b *testing.B) {
+	g := wgowut.NewGuiBuilder()
+	win := g.MakeWindow("table", "table", wgowut.Options{})
+	win.Add(LargeTable(g, 1000, 20))
+	b.ResetTimer()
+
+	size := 0
+	for i := 0; i < b.N; i++ {
+		size = HTMLSize(win)
+	}
+	b.ReportMetric(float64(size), "html-bytes")
+}
+
+func BenchmarkEventRefresh(b *testing.B) {
+	lw := NewLargeWindow(wgowut.NewGuiBuilder(), "large", 1000)
+	server, err := wgowuttest.NewServer(func(server gwu.Server) { server.AddWin(lw) })
+	if err != nil {
+		b.Fatal(err)
+	}
+	sess := server.NewSession()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := EventRefresh(sess, "large", lw.Buttons[i%len(lw.Buttons)].ID(), gwu.ETypeClick); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
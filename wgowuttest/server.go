@@ -0,0 +1,140 @@
+// Package wgowuttest provides a gwu server for tests that runs on a free local port and is driven by scripted
+// sessions over HTTP, like a browser would. It covers what unit tests of single components miss, for example state
+// leaking between sessions.
+package wgowuttest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/icza/gowut/gwu"
+)
+
+// StartTimeout is how long NewServer waits for the server to accept connections.
+const StartTimeout = 5 * time.Second
+
+// servers counts the servers started, giving each a unique app name: gwu registers its handlers with the default
+// http.ServeMux, which panics if a path is registered twice.
+var servers int32
+
+// Server is a gwu server started by NewServer. Servers can't be stopped and run until the test process exits.
+type Server struct {
+	gwu.Server
+	URL string // URL is the app URL of the server, ending with a slash.
+}
+
+// NewServer creates a gwu server listening on a free local port, calls setup to add the public windows, session
+// creator names and session handlers of the app to it, and starts it. It returns when the server accepts connections.
+func NewServer(setup func(server gwu.Server)) (*Server, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	appName := "wgowuttest" + strconv.Itoa(int(atomic.AddInt32(&servers, 1)))
+	server := gwu.NewServer(appName, addr)
+	if setup != nil {
+		setup(server)
+	}
+
+	started := make(chan error, 1)
+	go func() {
+		started <- server.Start()
+	}()
+
+	deadline := time.Now().Add(StartTimeout)
+	for {
+		select {
+		case err := <-started:
+			return nil, fmt.Errorf("wgowuttest: server stopped: %v", err)
+		default:
+		}
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+			return &Server{Server: server, URL: server.AppURL()}, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("wgowuttest: server not started in %v: %v", StartTimeout, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Session is a scripted client of a Server with its own cookies, so each Session gets its own gwu session when it
+// opens a window registered with AddSessCreatorName.
+type Session struct {
+	server *Server
+	client *http.Client
+}
+
+// NewSession returns a new client of the server without a gwu session.
+func (s *Server) NewSession() *Session {
+	jar, _ := cookiejar.New(nil) // never fails without options
+	return &Session{server: s, client: &http.Client{Jar: jar}}
+}
+
+// ID returns the id of the gwu session of the client (see gwu.Session.ID), or "" if it has none.
+func (c *Session) ID() string {
+	u, _ := url.Parse(c.server.URL)
+	for _, cookie := range c.client.Jar.Cookies(u) {
+		if cookie.Name == c.server.SessIDCookieName() {
+			return cookie.Value
+		}
+	}
+	return ""
+}
+
+// Open requests the window like a browser opening it, and returns its HTML. Opening a window registered with
+// AddSessCreatorName creates the gwu session of the client if it has none.
+func (c *Session) Open(winName string) (string, error) {
+	return c.get(winName, nil)
+}
+
+// Event sends an event of etype fired by the component to the window like the gwu JavaScript does, and returns the
+// actions of the response (like the ids of the components to re-render). value is the component value sent with the
+// event, which is the text of text boxes, "true" or "false" for check boxes and the comma separated selected indices
+// for list boxes; it's not sent if empty.
+func (c *Session) Event(winName string, id gwu.ID, etype gwu.EventType, value string) (string, error) {
+	params := url.Values{"et": {strconv.Itoa(int(etype))}, "cid": {id.String()}}
+	if value != "" {
+		params.Set("cval", value)
+	}
+	return c.get(winName+"/e", params)
+}
+
+// RenderComp returns the HTML of the component of the window, like the gwu JavaScript requests it when a component is
+// re-rendered.
+func (c *Session) RenderComp(winName string, id gwu.ID) (string, error) {
+	return c.get(winName+"/rc", url.Values{"cid": {id.String()}})
+}
+
+func (c *Session) get(path string, params url.Values) (string, error) {
+	u := c.server.URL + path
+	if params != nil {
+		u += "?" + params.Encode()
+	}
+	resp, err := c.client.Get(u)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return string(body), fmt.Errorf("wgowuttest: %s: %s", u, resp.Status)
+	}
+	return string(body), nil
+}
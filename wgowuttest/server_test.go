@@ -0,0 +1,102 @@
+package wgowuttest
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ddrake12/wgowut"
+	"github.com/icza/gowut/gwu"
+	"github.com/stretchr/testify/assert"
+)
+
+// echoApp builds a window per session echoing the text of a text box in a label, keeping the comps by session id.
+type echoApp struct {
+	mu    sync.Mutex
+	comps map[string][2]gwu.Comp
+}
+
+func (a *echoApp) Created(sess gwu.Session) {
+	g := wgowut.NewSessionBuilder(sess)
+	win := g.MakeWindow("main", "Main", wgowut.Options{})
+	tb := g.MakeTextBox("", wgowut.Options{})
+	label := g.MakeLabel("empty", wgowut.Options{})
+	tb.AddEHandlerFunc(func(e gwu.Event) {
+		label.SetText("echo " + tb.Text())
+		e.MarkDirty(label)
+	}, gwu.ETypeChange)
+	win.Add(tb)
+	win.Add(label)
+	sess.AddWin(win)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.comps[sess.ID()] = [2]gwu.Comp{tb, label}
+}
+
+func (a *echoApp) Removed(sess gwu.Session) {}
+
+func (a *echoApp) sessComps(id string) (tb, label gwu.Comp) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.comps[id][0], a.comps[id][1]
+}
+
+func TestServer(t *testing.T) {
+	app := &echoApp{comps: make(map[string][2]gwu.Comp)}
+	server, err := NewServer(func(server gwu.Server) {
+		server.AddSessCreatorName("main", "Main")
+		server.AddSHandler(app)
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, strings.HasPrefix(server.URL, "http://127.0.0.1:"))
+
+	// servers get unique app names
+	other, err := NewServer(nil)
+	if assert.NoError(t, err) {
+		assert.NotEqual(t, server.AppPath(), other.AppPath())
+	}
+
+	alice, bob := server.NewSession(), server.NewSession()
+	assert.Equal(t, "", alice.ID())
+	for _, c := range []*Session{alice, bob} {
+		html, err := c.Open("main")
+		assert.NoError(t, err)
+		assert.Contains(t, html, "empty")
+		assert.NotEqual(t, "", c.ID())
+	}
+	assert.NotEqual(t, alice.ID(), bob.ID())
+
+	// each session only sees its own changes
+	for _, test := range []struct {
+		c    *Session
+		text string
+	}{{alice, "alice"}, {bob, "bob"}} {
+		tb, label := app.sessComps(test.c.ID())
+		actions, err := test.c.Event("main", tb.ID(), gwu.ETypeChange, test.text)
+		assert.NoError(t, err)
+		assert.Contains(t, actions, label.ID().String())
+	}
+	for _, test := range []struct {
+		c    *Session
+		want string
+	}{{alice, "echo alice"}, {bob, "echo bob"}} {
+		_, label := app.sessComps(test.c.ID())
+		html, err := test.c.RenderComp("main", label.ID())
+		assert.NoError(t, err)
+		assert.Contains(t, html, test.want)
+
+		html, err = test.c.Open("main")
+		assert.NoError(t, err)
+		assert.Contains(t, html, test.want)
+	}
+
+	// components of other sessions can't be reached
+	aliceTB, _ := app.sessComps(alice.ID())
+	_, err = bob.Event("main", aliceTB.ID(), gwu.ETypeChange, "mallory")
+	assert.Error(t, err)
+	_, err = server.NewSession().RenderComp("missing", aliceTB.ID())
+	assert.Error(t, err)
+}